@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 )
 
 var ProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
@@ -24,7 +25,7 @@ var ProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, erro
 			return nil, err
 		}
 
-		return muxServer.ProviderServer(), nil
+		return helper.NewAPIRequestServer(muxServer.ProviderServer()), nil
 	},
 }
 
@@ -33,6 +33,9 @@ const (
 	optInTestsEnvVar         = "ACC_OPT_IN_TESTS"
 	SkipInstanceReadyPollKey = "skip_instance_ready_poll"
 
+	recorderReplayToken = "recorder-replay-token"
+	recorderSeed        = 1
+
 	runLongTestsEnvVar  = "RUN_LONG_TESTS"
 	skipLongTestMessage = "This test has been marked as a long-running test and is skipped by default. " +
 		"If you would like to run this test, please set the RUN_LONG_TEST environment variable to true."
@@ -98,7 +101,47 @@ func initTestImages() {
 	TestImagePrevious = images[1].ID
 }
 
+// initRecorder records API interactions to or replays them from a cassette
+// depending on the LINODE_RECORDER_MODE environment variable.
+func initRecorder() {
+	mode := helper.RecorderMode(os.Getenv(helper.EnvRecorderMode))
+
+	switch mode {
+	case helper.RecorderModeDisabled:
+		return
+	case helper.RecorderModeRecord, helper.RecorderModeReplay:
+	default:
+		log.Fatalf("invalid %s %q, must be %q or %q",
+			helper.EnvRecorderMode, mode, helper.RecorderModeRecord, helper.RecorderModeReplay)
+	}
+
+	cassettePath := os.Getenv(helper.EnvRecorderCassette)
+	if cassettePath == "" {
+		testBinary := strings.TrimSuffix(filepath.Base(os.Args[0]), ".test")
+		cassettePath = filepath.Join("testdata", "cassettes", testBinary+".jsonl")
+	}
+
+	if mode == helper.RecorderModeReplay {
+		// Replayed interactions are not authenticated and finish immediately
+		if os.Getenv("LINODE_TOKEN") == "" {
+			os.Setenv("LINODE_TOKEN", recorderReplayToken)
+		}
+
+		if os.Getenv("LINODE_EVENT_POLL_MS") == "" {
+			os.Setenv("LINODE_EVENT_POLL_MS", "10")
+		}
+	}
+
+	// Generated resource names must match between recording and replaying
+	//nolint:staticcheck
+	rand.Seed(recorderSeed)
+
+	helper.ConfigureRecorder(mode, cassettePath)
+}
+
 func init() {
+	initRecorder()
+
 	var err error
 	PublicKeyMaterial, privateKeyMaterial, err = acctest.RandSSHKeyPair("linode@ssh-acceptance-test")
 	if err != nil {
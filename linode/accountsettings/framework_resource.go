@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
@@ -44,7 +45,7 @@ func (r *Resource) Create(
 
 	// Update the account
 	resp.Diagnostics.Append(
-		r.updateAccountSettings(ctx, &plan)...,
+		r.updateAccountSettings(ctx, req.Plan, &plan)...,
 	)
 	if resp.Diagnostics.HasError() {
 		return
@@ -118,7 +119,7 @@ func (r *Resource) Update(
 
 	// Update the account
 	resp.Diagnostics.Append(
-		r.updateAccountSettings(ctx, &plan)...,
+		r.updateAccountSettings(ctx, req.Plan, &plan)...,
 	)
 	if resp.Diagnostics.HasError() {
 		return
@@ -147,6 +148,7 @@ func (r *Resource) Delete(
 
 func (r *Resource) updateAccountSettings(
 	ctx context.Context,
+	tfPlan tfsdk.Plan,
 	plan *AccountSettingsModel,
 ) diag.Diagnostics {
 	client := r.Meta.Client
@@ -164,9 +166,10 @@ func (r *Resource) updateAccountSettings(
 
 		_, err := client.UpdateLongviewPlan(ctx, options)
 		if err != nil {
-			diagnostics.AddError(
+			helper.FrameworkAddAPIError(
+				ctx, &diagnostics, tfPlan,
 				"Failed to update Linode Longview Plan",
-				err.Error(),
+				err,
 			)
 			return diagnostics
 		}
@@ -183,7 +186,11 @@ func (r *Resource) updateAccountSettings(
 
 	settings, err := client.UpdateAccountSettings(ctx, updateOpts)
 	if err != nil {
-		diagnostics.AddError("Failed to update Linode Account Settings", err.Error())
+		helper.FrameworkAddAPIError(
+			ctx, &diagnostics, tfPlan,
+			"Failed to update Linode Account Settings",
+			err,
+		)
 		return diagnostics
 	}
 
@@ -0,0 +1,66 @@
+package databasemysql
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+// defaultPort is the port Linode Managed MySQL databases accept connections on.
+// It is hardcoded because linodego.MySQLDatabase does not expose the port
+// returned by the API, unlike linodego.PostgresDatabase.
+const defaultPort = 3306
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return helper.NewDatabaseCredentialsEphemeralResource(
+		helper.BaseEphemeralResourceConfig{
+			Name:   "linode_database_mysql_credentials",
+			Schema: &frameworkEphemeralResourceSchema,
+		},
+		helper.DatabaseCredentialsEngine{
+			Label:            "MySQL",
+			GetHosts:         getHosts,
+			GetCACert:        getCACert,
+			GetCredentials:   getCredentials,
+			ResetCredentials: resetCredentials,
+		},
+	)
+}
+
+func getHosts(ctx context.Context, client *linodego.Client, id int) (linodego.DatabaseHost, int, error) {
+	db, err := client.GetMySQLDatabase(ctx, id)
+	if err != nil {
+		return linodego.DatabaseHost{}, 0, err
+	}
+
+	return db.Hosts, defaultPort, nil
+}
+
+func getCACert(ctx context.Context, client *linodego.Client, id int) ([]byte, error) {
+	cert, err := client.GetMySQLDatabaseSSL(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return cert.CACertificate, nil
+}
+
+func getCredentials(ctx context.Context, client *linodego.Client, id int) (string, string, error) {
+	cred, err := client.GetMySQLDatabaseCredentials(ctx, id)
+	if err != nil {
+		return "", "", err
+	}
+
+	return cred.Username, cred.Password, nil
+}
+
+func resetCredentials(ctx context.Context, client *linodego.Client, id int) error {
+	tflog.Debug(ctx, "client.ResetMySQLDatabaseCredentials(...)", map[string]any{
+		"database_id": id,
+	})
+
+	return client.ResetMySQLDatabaseCredentials(ctx, id)
+}
@@ -0,0 +1,61 @@
+package databasepostgresql
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return helper.NewDatabaseCredentialsEphemeralResource(
+		helper.BaseEphemeralResourceConfig{
+			Name:   "linode_database_postgresql_credentials",
+			Schema: &frameworkEphemeralResourceSchema,
+		},
+		helper.DatabaseCredentialsEngine{
+			Label:            "PostgreSQL",
+			GetHosts:         getHosts,
+			GetCACert:        getCACert,
+			GetCredentials:   getCredentials,
+			ResetCredentials: resetCredentials,
+		},
+	)
+}
+
+func getHosts(ctx context.Context, client *linodego.Client, id int) (linodego.DatabaseHost, int, error) {
+	db, err := client.GetPostgresDatabase(ctx, id)
+	if err != nil {
+		return linodego.DatabaseHost{}, 0, err
+	}
+
+	return db.Hosts, db.Port, nil
+}
+
+func getCACert(ctx context.Context, client *linodego.Client, id int) ([]byte, error) {
+	cert, err := client.GetPostgresDatabaseSSL(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return cert.CACertificate, nil
+}
+
+func getCredentials(ctx context.Context, client *linodego.Client, id int) (string, string, error) {
+	cred, err := client.GetPostgresDatabaseCredentials(ctx, id)
+	if err != nil {
+		return "", "", err
+	}
+
+	return cred.Username, cred.Password, nil
+}
+
+func resetCredentials(ctx context.Context, client *linodego.Client, id int) error {
+	tflog.Debug(ctx, "client.ResetPostgresDatabaseCredentials(...)", map[string]any{
+		"database_id": id,
+	})
+
+	return client.ResetPostgresDatabaseCredentials(ctx, id)
+}
@@ -0,0 +1,44 @@
+package databasepostgresql
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+)
+
+var frameworkEphemeralResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"database_id": schema.Int64Attribute{
+			Description: "The ID of the PostgreSQL database.",
+			Required:    true,
+		},
+		"rotate_on_close": schema.BoolAttribute{
+			Description: "If true, the root credentials of the database will be reset " +
+				"once Terraform is done using them.",
+			Optional: true,
+		},
+		"root_username": schema.StringAttribute{
+			Description: "The root username for the PostgreSQL database.",
+			Computed:    true,
+		},
+		"root_password": schema.StringAttribute{
+			Description: "The randomly-generated root password for the PostgreSQL database.",
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"host_primary": schema.StringAttribute{
+			Description: "The primary host for the PostgreSQL database.",
+			Computed:    true,
+		},
+		"host_secondary": schema.StringAttribute{
+			Description: "The secondary host for the PostgreSQL database.",
+			Computed:    true,
+		},
+		"port": schema.Int64Attribute{
+			Description: "The port to connect to the PostgreSQL database on.",
+			Computed:    true,
+		},
+		"ca_cert": schema.StringAttribute{
+			Description: "The base64-encoded SSL CA certificate for the PostgreSQL database.",
+			Computed:    true,
+		},
+	},
+}
@@ -25,13 +25,31 @@ func Resource() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			linodediffs.ComputedWithDefault("tags", []string{}),
 			linodediffs.CaseInsensitiveSet("tags"),
+			linodediffs.TagsAll(),
 		),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: helper.ImportStatePassthroughLabelContext("linode_domain", importLabelConfig),
 		},
 	}
 }
 
+var importLabelConfig = helper.ImportLabelConfig{
+	LabelField: "domain",
+	Lookup: func(ctx context.Context, client *linodego.Client, filter string) ([]string, error) {
+		domains, err := client.ListDomains(ctx, linodego.NewListOptions(0, filter))
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]string, 0, len(domains))
+		for _, domain := range domains {
+			result = append(result, strconv.Itoa(domain.ID))
+		}
+
+		return result, nil
+	},
+}
+
 func readResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ctx = populateLogAttributes(ctx, d)
 	tflog.Debug(ctx, "Read linode_domain")
@@ -66,7 +84,7 @@ func readResource(ctx context.Context, d *schema.ResourceData, meta interface{})
 	d.Set("expire_sec", domain.ExpireSec)
 	d.Set("refresh_sec", domain.RefreshSec)
 	d.Set("soa_email", domain.SOAEmail)
-	d.Set("tags", domain.Tags)
+	meta.(*helper.ProviderMeta).Config.GetProviderTags().SDKv2SetTags(d, domain.Tags)
 
 	return nil
 }
@@ -88,11 +106,9 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		TTLSec:      d.Get("ttl_sec").(int),
 	}
 
-	if tagsRaw, tagsOk := d.GetOk("tags"); tagsOk {
-		for _, tag := range tagsRaw.(*schema.Set).List() {
-			createOpts.Tags = append(createOpts.Tags, tag.(string))
-		}
-	}
+	createOpts.Tags = meta.(*helper.ProviderMeta).Config.GetProviderTags().MergeTags(
+		helper.ExpandStringSet(d.Get("tags").(*schema.Set)),
+	)
 
 	if v, ok := d.GetOk("master_ips"); ok {
 		v := v.(*schema.Set).List()
@@ -168,9 +184,23 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
-		tags := helper.ExpandStringSet(d.Get("tags").(*schema.Set))
-		updateOpts.Tags = tags
+	if d.HasChanges("tags", "tags_all") {
+		providerTags := meta.(*helper.ProviderMeta).Config.GetProviderTags()
+
+		// Ignored tags must be preserved when updating the tags
+		var remoteTags []string
+		if providerTags.HasIgnoreTags() {
+			domain, err := client.GetDomain(ctx, id)
+			if err != nil {
+				return diag.Errorf("Error fetching data about the current Linode Domain: %s", err)
+			}
+			remoteTags = domain.Tags
+		}
+
+		updateOpts.Tags = providerTags.UpdateTags(
+			helper.ExpandStringSet(d.Get("tags").(*schema.Set)),
+			remoteTags,
+		)
 	}
 
 	tflog.Debug(ctx, "client.UpdateDomain(...)", map[string]any{
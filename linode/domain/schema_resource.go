@@ -102,4 +102,10 @@ var resourceSchema = map[string]*schema.Schema{
 		Computed:    true,
 		Description: "An array of tags applied to this object. Tags are for organizational purposes only.",
 	},
+	"tags_all": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+		Description: "All tags applied to this object, including those inherited from the provider's default_tags.",
+	},
 }
@@ -0,0 +1,119 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+// CommandName is the name of the provider binary subcommand running the export.
+const CommandName = "export"
+
+// stringListFlag is a repeatable flag accepting comma-separated values.
+type stringListFlag []string
+
+func (f *stringListFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringListFlag) Set(value string) error {
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*f = append(*f, v)
+		}
+	}
+
+	return nil
+}
+
+// Run parses the given command line arguments and exports the
+// configuration of the account the configured token belongs to.
+// The client is configured using the same environment variables as the provider.
+func Run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	var options Options
+	var outPath, configProfile string
+
+	flags := flag.NewFlagSet(CommandName, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: terraform-provider-linode %s [options]\n\n", CommandName)
+		fmt.Fprintf(flags.Output(),
+			"Writes Terraform configuration and import blocks for the existing entities of a Linode account.\n"+
+				"The API token is read from the LINODE_TOKEN environment variable or the Linode CLI config.\n\n")
+		flags.PrintDefaults()
+	}
+
+	flags.Var((*stringListFlag)(&options.Types), "type",
+		fmt.Sprintf("only export entities of the given type, can be repeated (%s)", strings.Join(EntityTypes, ", ")))
+	flags.Var((*stringListFlag)(&options.Tags), "tag", "only export entities with the given tag, can be repeated")
+	flags.Var((*stringListFlag)(&options.Regions), "region", "only export entities in the given region, can be repeated")
+	flags.StringVar(&outPath, "out", "", "the file to write the configuration to, defaults to stdout")
+	flags.StringVar(&configProfile, "profile", "default", "the Linode CLI config profile to use")
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	if flags.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(flags.Args(), " "))
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return fmt.Errorf("failed to get user home directory: %w", err)
+	}
+
+	childAccountEUUID := os.Getenv("LINODE_CHILD_ACCOUNT_EUUID")
+
+	config := &helper.Config{
+		AccessToken:       os.Getenv("LINODE_TOKEN"),
+		APIURL:            os.Getenv("LINODE_URL"),
+		APIVersion:        os.Getenv("LINODE_API_VERSION"),
+		UAPrefix:          os.Getenv("LINODE_UA_PREFIX"),
+		ChildAccountEUUID: childAccountEUUID,
+		ConfigPath:        filepath.Join(homeDir, ".config", "linode"),
+		ConfigProfile:     configProfile,
+
+		// The export only lists entities, so any other request is a bug.
+		// Proxy tokens for child accounts are created using POST requests,
+		// so the guard can't be used when exporting a child account.
+		ReadOnly: childAccountEUUID == "",
+	}
+
+	client, err := config.Client(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create Linode client: %w", err)
+	}
+
+	w := stdout
+
+	if outPath != "" {
+		f, err := os.Create(filepath.Clean(outPath))
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	exporter := NewExporter(client, options)
+
+	if err := exporter.Export(ctx, w); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stderr, "Exported %d resources\n", exporter.Count())
+
+	return nil
+}
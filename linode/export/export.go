@@ -0,0 +1,263 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/linode/linodego"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// EntityTypes contains the types of entities that can be exported,
+// in the order they are exported.
+var EntityTypes = []string{
+	"instance",
+	"volume",
+	"nodebalancer",
+	"firewall",
+	"domain",
+	"vpc",
+	"lke",
+}
+
+var invalidNameCharsRegex = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// Options contains the options used to filter exported entities.
+type Options struct {
+	// The types of entities to export, defaults to all EntityTypes.
+	Types []string
+
+	// Only export entities with at least one of the given tags.
+	// Does not apply to entity types without tags.
+	Tags []string
+
+	// Only export entities in one of the given regions.
+	// Does not apply to entity types without a region.
+	Regions []string
+}
+
+// Exporter generates Terraform configuration and import blocks
+// for the existing entities of a Linode account.
+type Exporter struct {
+	client  *linodego.Client
+	options Options
+
+	file *hclwrite.File
+
+	// The names of all generated resources by resource type
+	names map[string]map[string]bool
+
+	// The names of exported resources by resource type and ID,
+	// used to reference them from other resources.
+	refs map[string]map[int]string
+
+	// Writes deferred until all entities have been exported
+	deferred []func()
+
+	// The number of exported resources
+	count int
+}
+
+// NewExporter returns a new Exporter for the given client and options.
+func NewExporter(client *linodego.Client, options Options) *Exporter {
+	return &Exporter{
+		client:  client,
+		options: options,
+		file:    hclwrite.NewEmptyFile(),
+		names:   make(map[string]map[string]bool),
+		refs:    make(map[string]map[int]string),
+	}
+}
+
+// Export lists all matching entities of the account and writes their
+// configuration and import blocks to the given writer.
+func (e *Exporter) Export(ctx context.Context, w io.Writer) error {
+	exporters := map[string]func(ctx context.Context) error{
+		"instance":     e.exportInstances,
+		"volume":       e.exportVolumes,
+		"nodebalancer": e.exportNodeBalancers,
+		"firewall":     e.exportFirewalls,
+		"domain":       e.exportDomains,
+		"vpc":          e.exportVPCs,
+		"lke":          e.exportLKEClusters,
+	}
+
+	for _, t := range e.options.Types {
+		if _, ok := exporters[t]; !ok {
+			return fmt.Errorf("unsupported entity type %q, expected one of: %s", t, strings.Join(EntityTypes, ", "))
+		}
+	}
+
+	for _, t := range EntityTypes {
+		if len(e.options.Types) > 0 && !slices.Contains(e.options.Types, t) {
+			continue
+		}
+
+		if err := exporters[t](ctx); err != nil {
+			return fmt.Errorf("failed to export %s entities: %w", t, err)
+		}
+	}
+
+	for _, write := range e.deferred {
+		write()
+	}
+
+	_, err := w.Write(e.file.Bytes())
+	return err
+}
+
+// deferWrite defers writing resources until all entities have been
+// exported, so they can reference entities of types exported later.
+func (e *Exporter) deferWrite(write func()) {
+	e.deferred = append(e.deferred, write)
+}
+
+// Count returns the number of resources exported so far.
+func (e *Exporter) Count() int {
+	return e.count
+}
+
+// matches returns whether an entity with the given region
+// and tags matches the configured filters.
+func (e *Exporter) matches(region string, tags []string, hasRegion, hasTags bool) bool {
+	if hasRegion && len(e.options.Regions) > 0 && !slices.Contains(e.options.Regions, region) {
+		return false
+	}
+
+	if hasTags && len(e.options.Tags) > 0 {
+		return slices.ContainsFunc(tags, func(tag string) bool {
+			return slices.ContainsFunc(e.options.Tags, func(t string) bool {
+				return strings.EqualFold(t, tag)
+			})
+		})
+	}
+
+	return true
+}
+
+// resourceName returns a unique resource name for the given
+// resource type derived from the given label.
+func (e *Exporter) resourceName(resourceType, label string) string {
+	name := strings.Trim(invalidNameCharsRegex.ReplaceAllString(strings.ToLower(label), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') || name[0] == '-' {
+		name = "r_" + name
+	}
+
+	if e.names[resourceType] == nil {
+		e.names[resourceType] = make(map[string]bool)
+	}
+
+	result := name
+	for i := 2; e.names[resourceType][result]; i++ {
+		result = fmt.Sprintf("%s_%d", name, i)
+	}
+
+	e.names[resourceType][result] = true
+
+	return result
+}
+
+// addResource appends an import block and a resource block for the given
+// entity and returns the body of the resource block.
+func (e *Exporter) addResource(resourceType, label string, id int, importID string) *hclwrite.Body {
+	name := e.resourceName(resourceType, label)
+
+	if e.refs[resourceType] == nil {
+		e.refs[resourceType] = make(map[int]string)
+	}
+	e.refs[resourceType][id] = name
+
+	body := e.file.Body()
+
+	importBody := body.AppendNewBlock("import", nil).Body()
+	importBody.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: name},
+	})
+	importBody.SetAttributeValue("id", cty.StringVal(importID))
+	body.AppendNewline()
+
+	resourceBlock := body.AppendNewBlock("resource", []string{resourceType, name})
+	body.AppendNewline()
+
+	e.count++
+
+	return resourceBlock.Body()
+}
+
+// setReference sets the given attribute to a reference to the exported
+// resource with the given ID, or to the ID itself if it was not exported.
+func (e *Exporter) setReference(body *hclwrite.Body, attrName, resourceType string, id int) {
+	if name, ok := e.refs[resourceType][id]; ok {
+		body.SetAttributeTraversal(attrName, hcl.Traversal{
+			hcl.TraverseRoot{Name: resourceType},
+			hcl.TraverseAttr{Name: name},
+			hcl.TraverseAttr{Name: "id"},
+		})
+		return
+	}
+
+	body.SetAttributeValue(attrName, cty.NumberIntVal(int64(id)))
+}
+
+// setReferenceList sets the given attribute to a list of references
+// to the exported resources with the given IDs.
+func (e *Exporter) setReferenceList(body *hclwrite.Body, attrName, resourceType string, ids []int) {
+	elems := make([]hclwrite.Tokens, len(ids))
+
+	for i, id := range ids {
+		if name, ok := e.refs[resourceType][id]; ok {
+			elems[i] = hclwrite.TokensForTraversal(hcl.Traversal{
+				hcl.TraverseRoot{Name: resourceType},
+				hcl.TraverseAttr{Name: name},
+				hcl.TraverseAttr{Name: "id"},
+			})
+			continue
+		}
+
+		elems[i] = hclwrite.TokensForValue(cty.NumberIntVal(int64(id)))
+	}
+
+	body.SetAttributeRaw(attrName, hclwrite.TokensForTuple(elems))
+}
+
+func setString(body *hclwrite.Body, attrName, value string) {
+	if value != "" {
+		body.SetAttributeValue(attrName, cty.StringVal(value))
+	}
+}
+
+func setInt(body *hclwrite.Body, attrName string, value int) {
+	if value != 0 {
+		body.SetAttributeValue(attrName, cty.NumberIntVal(int64(value)))
+	}
+}
+
+func setStringList(body *hclwrite.Body, attrName string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+
+	result := make([]cty.Value, len(values))
+	for i, v := range values {
+		result[i] = cty.StringVal(v)
+	}
+
+	body.SetAttributeValue(attrName, cty.ListVal(result))
+}
+
+func joinIDs(ids ...int) string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = strconv.Itoa(id)
+	}
+
+	return strings.Join(result, ",")
+}
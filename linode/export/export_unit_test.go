@@ -0,0 +1,219 @@
+//go:build unit
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/fakeapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceName(t *testing.T) {
+	e := NewExporter(nil, Options{})
+
+	assert.Equal(t, "my_linode", e.resourceName("linode_instance", "My Linode"))
+	assert.Equal(t, "my_linode_2", e.resourceName("linode_instance", "my.linode"))
+	assert.Equal(t, "my_linode", e.resourceName("linode_volume", "my_linode"))
+	assert.Equal(t, "r_123-web", e.resourceName("linode_instance", "123-web"))
+	assert.Equal(t, "r_", e.resourceName("linode_instance", ""))
+}
+
+func TestMatches(t *testing.T) {
+	e := NewExporter(nil, Options{
+		Tags:    []string{"legacy"},
+		Regions: []string{"us-east"},
+	})
+
+	assert.True(t, e.matches("us-east", []string{"web", "Legacy"}, true, true))
+	assert.False(t, e.matches("us-west", []string{"legacy"}, true, true))
+	assert.False(t, e.matches("us-east", []string{"web"}, true, true))
+
+	// Filters do not apply to entity types without regions or tags
+	assert.True(t, e.matches("", []string{"legacy"}, false, true))
+	assert.True(t, e.matches("us-east", nil, true, false))
+}
+
+func TestWriteResources(t *testing.T) {
+	e := NewExporter(nil, Options{})
+
+	instance := linodego.Instance{
+		ID:     123,
+		Label:  "web-1",
+		Region: "us-east",
+		Type:   "g6-standard-1",
+		Tags:   []string{"legacy"},
+	}
+	e.writeInstance(instance)
+
+	linodeID := 123
+	e.writeVolume(linodego.Volume{
+		ID:       456,
+		Label:    "data",
+		Region:   "us-east",
+		Size:     20,
+		LinodeID: &linodeID,
+	})
+
+	e.writeInstanceDisk(instance, linodego.InstanceDisk{ID: 1, Label: "boot", Size: 25000, Filesystem: "ext4"})
+	e.writeInstanceConfig(instance, linodego.InstanceConfig{
+		ID:         2,
+		Label:      "config",
+		Kernel:     "linode/grub2",
+		RootDevice: "/dev/sda",
+		Devices: &linodego.InstanceConfigDeviceMap{
+			SDA: &linodego.InstanceConfigDevice{DiskID: 1},
+			SDB: &linodego.InstanceConfigDevice{VolumeID: 456},
+		},
+	})
+
+	e.writeFirewall(
+		linodego.Firewall{
+			ID:    789,
+			Label: "web",
+			Rules: linodego.FirewallRuleSet{
+				InboundPolicy:  "DROP",
+				OutboundPolicy: "ACCEPT",
+				Inbound: []linodego.FirewallRule{
+					{
+						Label:     "allow-http",
+						Action:    "ACCEPT",
+						Protocol:  "TCP",
+						Ports:     "80",
+						Addresses: linodego.NetworkAddresses{IPv4: &[]string{"0.0.0.0/0"}},
+					},
+				},
+			},
+		},
+		[]linodego.FirewallDevice{
+			{Entity: linodego.FirewallDeviceEntity{ID: 123, Type: linodego.FirewallDeviceLinode}},
+			{Entity: linodego.FirewallDeviceEntity{ID: 999, Type: linodego.FirewallDeviceLinode}},
+		},
+	)
+
+	domain := linodego.Domain{ID: 10, Domain: "example.com", Type: "master", SOAEmail: "admin@example.com"}
+	e.writeDomain(domain)
+	e.writeDomainRecord(domain, linodego.DomainRecord{ID: 11, Type: "A", Name: "www", Target: "192.0.2.1"})
+
+	result := string(e.file.Bytes())
+
+	assert.Contains(t, result, "import {\n  to = linode_instance.web-1\n  id = \"123\"\n}")
+	assert.Contains(t, result, "resource \"linode_instance\" \"web-1\" {")
+	assert.NotContains(t, result, "disk {")
+	assert.Contains(t, result, "import {\n  to = linode_instance_disk.web-1_boot\n  id = \"123,1\"\n}")
+	assert.Contains(t, result, "import {\n  to = linode_instance_config.web-1_config\n  id = \"123,2\"\n}")
+	assert.Contains(t, result, "disk_id     = linode_instance_disk.web-1_boot.id")
+	assert.Contains(t, result, "volume_id   = linode_volume.data.id")
+	assert.Contains(t, result, "linode_id = linode_instance.web-1.id")
+	assert.Contains(t, result, "linodes         = [linode_instance.web-1.id, 999]")
+	assert.Contains(t, result, "ipv4     = [\"0.0.0.0/0\"]")
+	assert.Contains(t, result, "import {\n  to = linode_domain_record.example_com_www_a\n  id = \"10,11\"\n}")
+	assert.Contains(t, result, "domain_id   = linode_domain.example_com.id")
+	assert.Equal(t, 7, e.Count())
+}
+
+func TestExport(t *testing.T) {
+	ctx := context.Background()
+
+	server := fakeapi.New()
+	t.Cleanup(server.Close)
+
+	client, err := server.Config().Client(ctx)
+	require.NoError(t, err)
+
+	for _, opts := range []linodego.VolumeCreateOptions{
+		{Label: "legacy-data", Region: "us-east", Size: 20, Tags: []string{"legacy"}},
+		{Label: "other-data", Region: "us-east", Size: 20},
+		{Label: "west-data", Region: "us-southeast", Size: 20, Tags: []string{"legacy"}},
+	} {
+		_, err := client.CreateVolume(ctx, opts)
+		require.NoError(t, err)
+	}
+
+	domain, err := client.CreateDomain(ctx, linodego.DomainCreateOptions{
+		Domain:   "example.com",
+		Type:     linodego.DomainTypeMaster,
+		SOAEmail: "admin@example.com",
+		Tags:     []string{"legacy"},
+	})
+	require.NoError(t, err)
+
+	_, err = client.CreateDomainRecord(ctx, domain.ID, linodego.DomainRecordCreateOptions{
+		Type:   linodego.RecordTypeA,
+		Name:   "www",
+		Target: "192.0.2.1",
+	})
+	require.NoError(t, err)
+
+	exporter := NewExporter(client, Options{
+		Types:   []string{"volume", "domain"},
+		Tags:    []string{"legacy"},
+		Regions: []string{"us-east"},
+	})
+
+	var buf bytes.Buffer
+	require.NoError(t, exporter.Export(ctx, &buf))
+
+	result := buf.String()
+
+	assert.Contains(t, result, `resource "linode_volume" "legacy-data"`)
+	assert.NotContains(t, result, "other-data")
+	assert.NotContains(t, result, "west-data")
+	assert.Contains(t, result, `resource "linode_domain" "example_com"`)
+	assert.Contains(t, result, `resource "linode_domain_record" "example_com_www_a"`)
+	assert.Equal(t, 3, exporter.Count())
+
+	err = NewExporter(client, Options{Types: []string{"database"}}).Export(ctx, &buf)
+	assert.ErrorContains(t, err, `unsupported entity type "database"`)
+}
+
+func TestExport_instanceConfigReferencesVolume(t *testing.T) {
+	ctx := context.Background()
+
+	server := fakeapi.New()
+	t.Cleanup(server.Close)
+
+	client, err := server.Config().Client(ctx)
+	require.NoError(t, err)
+
+	instance, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{
+		Label:  "web",
+		Region: "us-east",
+		Type:   "g6-nanode-1",
+	})
+	require.NoError(t, err)
+
+	volume, err := client.CreateVolume(ctx, linodego.VolumeCreateOptions{
+		Label:  "data",
+		Region: "us-east",
+		Size:   20,
+	})
+	require.NoError(t, err)
+
+	_, err = client.CreateInstanceConfig(ctx, instance.ID, linodego.InstanceConfigCreateOptions{
+		Label: "boot",
+		Devices: linodego.InstanceConfigDeviceMap{
+			SDA: &linodego.InstanceConfigDevice{VolumeID: volume.ID},
+		},
+	})
+	require.NoError(t, err)
+
+	exporter := NewExporter(client, Options{Types: []string{"instance", "volume"}})
+
+	var buf bytes.Buffer
+	require.NoError(t, exporter.Export(ctx, &buf))
+
+	result := buf.String()
+
+	assert.Contains(t, result, `resource "linode_instance_config" "web_boot"`)
+	assert.Contains(t, result, "volume_id   = linode_volume.data.id")
+	assert.Less(t,
+		strings.Index(result, `resource "linode_volume" "data"`),
+		strings.Index(result, `resource "linode_instance_config" "web_boot"`),
+	)
+}
@@ -0,0 +1,479 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/linode/linodego"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func (e *Exporter) exportInstances(ctx context.Context) error {
+	instances, err := e.client.ListInstances(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, instance := range instances {
+		// Nodes of LKE clusters are managed by the cluster
+		if instance.LKEClusterID != 0 || !e.matches(instance.Region, instance.Tags, true, true) {
+			continue
+		}
+
+		disks, err := e.client.ListInstanceDisks(ctx, instance.ID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list disks of instance %d: %w", instance.ID, err)
+		}
+
+		configs, err := e.client.ListInstanceConfigs(ctx, instance.ID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list configs of instance %d: %w", instance.ID, err)
+		}
+
+		e.writeInstance(instance)
+
+		// Configs are written after all other entities
+		// so they can reference the exported volumes.
+		e.deferWrite(func() {
+			for _, disk := range disks {
+				e.writeInstanceDisk(instance, disk)
+			}
+
+			for _, config := range configs {
+				e.writeInstanceConfig(instance, config)
+			}
+		})
+	}
+
+	return nil
+}
+
+func (e *Exporter) writeInstance(instance linodego.Instance) {
+	body := e.addResource("linode_instance", instance.Label, instance.ID, strconv.Itoa(instance.ID))
+
+	setString(body, "label", instance.Label)
+	setString(body, "region", instance.Region)
+	setString(body, "type", instance.Type)
+	setStringList(body, "tags", instance.Tags)
+	body.SetAttributeValue("watchdog_enabled", cty.BoolVal(instance.WatchdogEnabled))
+}
+
+func (e *Exporter) writeInstanceDisk(instance linodego.Instance, disk linodego.InstanceDisk) {
+	body := e.addResource(
+		"linode_instance_disk",
+		fmt.Sprintf("%s_%s", instance.Label, disk.Label),
+		disk.ID,
+		joinIDs(instance.ID, disk.ID),
+	)
+
+	e.setReference(body, "linode_id", "linode_instance", instance.ID)
+	setString(body, "label", disk.Label)
+	setInt(body, "size", disk.Size)
+	setString(body, "filesystem", string(disk.Filesystem))
+}
+
+func (e *Exporter) writeInstanceConfig(instance linodego.Instance, config linodego.InstanceConfig) {
+	body := e.addResource(
+		"linode_instance_config",
+		fmt.Sprintf("%s_%s", instance.Label, config.Label),
+		config.ID,
+		joinIDs(instance.ID, config.ID),
+	)
+
+	e.setReference(body, "linode_id", "linode_instance", instance.ID)
+	setString(body, "label", config.Label)
+	setString(body, "comments", config.Comments)
+	setString(body, "kernel", config.Kernel)
+	setString(body, "root_device", config.RootDevice)
+	setString(body, "run_level", config.RunLevel)
+	setString(body, "virt_mode", config.VirtMode)
+	setInt(body, "memory_limit", config.MemoryLimit)
+
+	if config.Devices == nil {
+		return
+	}
+
+	for _, device := range []struct {
+		name   string
+		device *linodego.InstanceConfigDevice
+	}{
+		{"sda", config.Devices.SDA},
+		{"sdb", config.Devices.SDB},
+		{"sdc", config.Devices.SDC},
+		{"sdd", config.Devices.SDD},
+		{"sde", config.Devices.SDE},
+		{"sdf", config.Devices.SDF},
+		{"sdg", config.Devices.SDG},
+		{"sdh", config.Devices.SDH},
+	} {
+		if device.device == nil || (device.device.DiskID == 0 && device.device.VolumeID == 0) {
+			continue
+		}
+
+		deviceBody := body.AppendNewBlock("device", nil).Body()
+		setString(deviceBody, "device_name", device.name)
+
+		if device.device.DiskID != 0 {
+			e.setReference(deviceBody, "disk_id", "linode_instance_disk", device.device.DiskID)
+			continue
+		}
+
+		e.setReference(deviceBody, "volume_id", "linode_volume", device.device.VolumeID)
+	}
+}
+
+func (e *Exporter) exportVolumes(ctx context.Context) error {
+	volumes, err := e.client.ListVolumes(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, volume := range volumes {
+		if !e.matches(volume.Region, volume.Tags, true, true) {
+			continue
+		}
+
+		e.writeVolume(volume)
+	}
+
+	return nil
+}
+
+func (e *Exporter) writeVolume(volume linodego.Volume) {
+	body := e.addResource("linode_volume", volume.Label, volume.ID, strconv.Itoa(volume.ID))
+
+	setString(body, "label", volume.Label)
+	setString(body, "region", volume.Region)
+	setInt(body, "size", volume.Size)
+	setStringList(body, "tags", volume.Tags)
+
+	if volume.LinodeID != nil {
+		e.setReference(body, "linode_id", "linode_instance", *volume.LinodeID)
+	}
+}
+
+func (e *Exporter) exportNodeBalancers(ctx context.Context) error {
+	nodeBalancers, err := e.client.ListNodeBalancers(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, nodeBalancer := range nodeBalancers {
+		if !e.matches(nodeBalancer.Region, nodeBalancer.Tags, true, true) {
+			continue
+		}
+
+		e.writeNodeBalancer(nodeBalancer)
+
+		configs, err := e.client.ListNodeBalancerConfigs(ctx, nodeBalancer.ID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list configs of NodeBalancer %d: %w", nodeBalancer.ID, err)
+		}
+
+		for _, config := range configs {
+			e.writeNodeBalancerConfig(config)
+
+			nodes, err := e.client.ListNodeBalancerNodes(ctx, nodeBalancer.ID, config.ID, nil)
+			if err != nil {
+				return fmt.Errorf("failed to list nodes of NodeBalancer config %d: %w", config.ID, err)
+			}
+
+			for _, node := range nodes {
+				e.writeNodeBalancerNode(node)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (e *Exporter) writeNodeBalancer(nodeBalancer linodego.NodeBalancer) {
+	var label string
+	if nodeBalancer.Label != nil {
+		label = *nodeBalancer.Label
+	}
+
+	body := e.addResource("linode_nodebalancer", label, nodeBalancer.ID, strconv.Itoa(nodeBalancer.ID))
+
+	setString(body, "label", label)
+	setString(body, "region", nodeBalancer.Region)
+	setInt(body, "client_conn_throttle", nodeBalancer.ClientConnThrottle)
+	setStringList(body, "tags", nodeBalancer.Tags)
+}
+
+func (e *Exporter) writeNodeBalancerConfig(config linodego.NodeBalancerConfig) {
+	body := e.addResource(
+		"linode_nodebalancer_config",
+		fmt.Sprintf("%s_%d", e.refs["linode_nodebalancer"][config.NodeBalancerID], config.Port),
+		config.ID,
+		joinIDs(config.NodeBalancerID, config.ID),
+	)
+
+	e.setReference(body, "nodebalancer_id", "linode_nodebalancer", config.NodeBalancerID)
+	setInt(body, "port", config.Port)
+	setString(body, "protocol", string(config.Protocol))
+	setString(body, "proxy_protocol", string(config.ProxyProtocol))
+	setString(body, "algorithm", string(config.Algorithm))
+	setString(body, "stickiness", string(config.Stickiness))
+	setString(body, "check", string(config.Check))
+	setInt(body, "check_interval", config.CheckInterval)
+	setInt(body, "check_timeout", config.CheckTimeout)
+	setInt(body, "check_attempts", config.CheckAttempts)
+	setString(body, "check_path", config.CheckPath)
+	setString(body, "check_body", config.CheckBody)
+	body.SetAttributeValue("check_passive", cty.BoolVal(config.CheckPassive))
+	setString(body, "cipher_suite", string(config.CipherSuite))
+}
+
+func (e *Exporter) writeNodeBalancerNode(node linodego.NodeBalancerNode) {
+	body := e.addResource(
+		"linode_nodebalancer_node",
+		node.Label,
+		node.ID,
+		joinIDs(node.NodeBalancerID, node.ConfigID, node.ID),
+	)
+
+	e.setReference(body, "nodebalancer_id", "linode_nodebalancer", node.NodeBalancerID)
+	e.setReference(body, "config_id", "linode_nodebalancer_config", node.ConfigID)
+	setString(body, "label", node.Label)
+	setString(body, "address", node.Address)
+	setInt(body, "weight", node.Weight)
+	setString(body, "mode", string(node.Mode))
+}
+
+func (e *Exporter) exportFirewalls(ctx context.Context) error {
+	firewalls, err := e.client.ListFirewalls(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, firewall := range firewalls {
+		if !e.matches("", firewall.Tags, false, true) {
+			continue
+		}
+
+		devices, err := e.client.ListFirewallDevices(ctx, firewall.ID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list devices of firewall %d: %w", firewall.ID, err)
+		}
+
+		e.writeFirewall(firewall, devices)
+	}
+
+	return nil
+}
+
+func (e *Exporter) writeFirewall(firewall linodego.Firewall, devices []linodego.FirewallDevice) {
+	body := e.addResource("linode_firewall", firewall.Label, firewall.ID, strconv.Itoa(firewall.ID))
+
+	setString(body, "label", firewall.Label)
+	setStringList(body, "tags", firewall.Tags)
+	setString(body, "inbound_policy", firewall.Rules.InboundPolicy)
+	setString(body, "outbound_policy", firewall.Rules.OutboundPolicy)
+
+	var linodeIDs, nodeBalancerIDs []int
+
+	for _, device := range devices {
+		switch device.Entity.Type {
+		case linodego.FirewallDeviceLinode:
+			linodeIDs = append(linodeIDs, device.Entity.ID)
+		case linodego.FirewallDeviceNodeBalancer:
+			nodeBalancerIDs = append(nodeBalancerIDs, device.Entity.ID)
+		}
+	}
+
+	if len(linodeIDs) > 0 {
+		e.setReferenceList(body, "linodes", "linode_instance", linodeIDs)
+	}
+
+	if len(nodeBalancerIDs) > 0 {
+		e.setReferenceList(body, "nodebalancers", "linode_nodebalancer", nodeBalancerIDs)
+	}
+
+	writeFirewallRules(body, "inbound", firewall.Rules.Inbound)
+	writeFirewallRules(body, "outbound", firewall.Rules.Outbound)
+}
+
+func writeFirewallRules(body *hclwrite.Body, blockName string, rules []linodego.FirewallRule) {
+	for _, rule := range rules {
+		ruleBody := body.AppendNewBlock(blockName, nil).Body()
+
+		setString(ruleBody, "label", rule.Label)
+		setString(ruleBody, "description", rule.Description)
+		setString(ruleBody, "action", rule.Action)
+		setString(ruleBody, "protocol", string(rule.Protocol))
+		setString(ruleBody, "ports", rule.Ports)
+
+		if rule.Addresses.IPv4 != nil {
+			setStringList(ruleBody, "ipv4", *rule.Addresses.IPv4)
+		}
+
+		if rule.Addresses.IPv6 != nil {
+			setStringList(ruleBody, "ipv6", *rule.Addresses.IPv6)
+		}
+	}
+}
+
+func (e *Exporter) exportDomains(ctx context.Context) error {
+	domains, err := e.client.ListDomains(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, domain := range domains {
+		if !e.matches("", domain.Tags, false, true) {
+			continue
+		}
+
+		e.writeDomain(domain)
+
+		records, err := e.client.ListDomainRecords(ctx, domain.ID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list records of domain %d: %w", domain.ID, err)
+		}
+
+		for _, record := range records {
+			e.writeDomainRecord(domain, record)
+		}
+	}
+
+	return nil
+}
+
+func (e *Exporter) writeDomain(domain linodego.Domain) {
+	body := e.addResource("linode_domain", domain.Domain, domain.ID, strconv.Itoa(domain.ID))
+
+	setString(body, "domain", domain.Domain)
+	setString(body, "type", string(domain.Type))
+	setString(body, "soa_email", domain.SOAEmail)
+	setString(body, "description", domain.Description)
+	setString(body, "group", domain.Group)
+	setStringList(body, "master_ips", domain.MasterIPs)
+	setStringList(body, "axfr_ips", domain.AXfrIPs)
+	setInt(body, "ttl_sec", domain.TTLSec)
+	setInt(body, "retry_sec", domain.RetrySec)
+	setInt(body, "expire_sec", domain.ExpireSec)
+	setInt(body, "refresh_sec", domain.RefreshSec)
+	setStringList(body, "tags", domain.Tags)
+}
+
+func (e *Exporter) writeDomainRecord(domain linodego.Domain, record linodego.DomainRecord) {
+	label := fmt.Sprintf("%s_%s_%s", domain.Domain, record.Name, record.Type)
+
+	body := e.addResource("linode_domain_record", label, record.ID, joinIDs(domain.ID, record.ID))
+
+	e.setReference(body, "domain_id", "linode_domain", domain.ID)
+	setString(body, "record_type", string(record.Type))
+	setString(body, "target", record.Target)
+	setInt(body, "ttl_sec", record.TTLSec)
+	setInt(body, "priority", record.Priority)
+	setInt(body, "weight", record.Weight)
+	setInt(body, "port", record.Port)
+
+	// The names of SRV records are generated from their service and protocol
+	if record.Type != linodego.RecordTypeSRV {
+		body.SetAttributeValue("name", cty.StringVal(record.Name))
+	}
+
+	if record.Service != nil {
+		setString(body, "service", *record.Service)
+	}
+
+	if record.Protocol != nil {
+		setString(body, "protocol", *record.Protocol)
+	}
+
+	if record.Tag != nil {
+		setString(body, "tag", *record.Tag)
+	}
+}
+
+func (e *Exporter) exportVPCs(ctx context.Context) error {
+	vpcs, err := e.client.ListVPCs(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, vpc := range vpcs {
+		if !e.matches(vpc.Region, nil, true, false) {
+			continue
+		}
+
+		e.writeVPC(vpc)
+	}
+
+	return nil
+}
+
+func (e *Exporter) writeVPC(vpc linodego.VPC) {
+	body := e.addResource("linode_vpc", vpc.Label, vpc.ID, strconv.Itoa(vpc.ID))
+
+	setString(body, "label", vpc.Label)
+	setString(body, "region", vpc.Region)
+	setString(body, "description", vpc.Description)
+
+	for _, subnet := range vpc.Subnets {
+		subnetBody := e.addResource(
+			"linode_vpc_subnet",
+			fmt.Sprintf("%s_%s", vpc.Label, subnet.Label),
+			subnet.ID,
+			joinIDs(vpc.ID, subnet.ID),
+		)
+
+		e.setReference(subnetBody, "vpc_id", "linode_vpc", vpc.ID)
+		setString(subnetBody, "label", subnet.Label)
+		setString(subnetBody, "ipv4", subnet.IPv4)
+	}
+}
+
+func (e *Exporter) exportLKEClusters(ctx context.Context) error {
+	clusters, err := e.client.ListLKEClusters(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, cluster := range clusters {
+		if !e.matches(cluster.Region, cluster.Tags, true, true) {
+			continue
+		}
+
+		pools, err := e.client.ListLKENodePools(ctx, cluster.ID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list node pools of LKE cluster %d: %w", cluster.ID, err)
+		}
+
+		e.writeLKECluster(cluster, pools)
+	}
+
+	return nil
+}
+
+func (e *Exporter) writeLKECluster(cluster linodego.LKECluster, pools []linodego.LKENodePool) {
+	body := e.addResource("linode_lke_cluster", cluster.Label, cluster.ID, strconv.Itoa(cluster.ID))
+
+	setString(body, "label", cluster.Label)
+	setString(body, "region", cluster.Region)
+	setString(body, "k8s_version", cluster.K8sVersion)
+	setStringList(body, "tags", cluster.Tags)
+
+	if cluster.ControlPlane.HighAvailability {
+		body.AppendNewBlock("control_plane", nil).Body().
+			SetAttributeValue("high_availability", cty.True)
+	}
+
+	for _, pool := range pools {
+		poolBody := body.AppendNewBlock("pool", nil).Body()
+
+		setString(poolBody, "type", pool.Type)
+		setInt(poolBody, "count", pool.Count)
+		setStringList(poolBody, "tags", pool.Tags)
+
+		if pool.Autoscaler.Enabled {
+			autoscalerBody := poolBody.AppendNewBlock("autoscaler", nil).Body()
+			setInt(autoscalerBody, "min", pool.Autoscaler.Min)
+			setInt(autoscalerBody, "max", pool.Autoscaler.Max)
+		}
+	}
+}
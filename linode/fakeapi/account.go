@@ -0,0 +1,98 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+const collectionEvents = "account/events"
+
+func (s *Server) registerAccountRoutes() {
+	s.handle(http.MethodGet, "account/events", s.listEvents)
+	s.handle(http.MethodGet, `account/events/(\d+)`, s.getEvent)
+	s.handle(http.MethodPost, `account/events/(\d+)/seen`, s.markEventSeen)
+}
+
+// entityRef builds the entity reference stored on events and firewall devices.
+func entityRef(entityType string, id int, label, url string) object {
+	return object{
+		"id":    id,
+		"type":  entityType,
+		"label": label,
+		"url":   url,
+	}
+}
+
+// addEvent records a finished event for the given entity.
+// All fake operations complete synchronously, so events are never
+// reported as started.
+func (s *Server) addEvent(action string, entity, secondaryEntity object) {
+	var secondary any
+	if secondaryEntity != nil {
+		secondary = secondaryEntity
+	}
+
+	s.insert(collectionEvents, object{
+		"action":           action,
+		"status":           "finished",
+		"percent_complete": 100,
+		"read":             false,
+		"seen":             false,
+		"username":         "fakeapi",
+		"entity":           entity,
+		"secondary_entity": secondary,
+		"rate":             nil,
+		"message":          "",
+	})
+}
+
+// Events returns all events recorded by the server, most recent first.
+func (s *Server) Events() []object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.sortedEvents()
+}
+
+func (s *Server) sortedEvents() []object {
+	events := s.list(collectionEvents)
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return asInt(events[i]["id"]) > asInt(events[j]["id"])
+	})
+
+	return events
+}
+
+func (s *Server) listEvents(w http.ResponseWriter, r *http.Request, _ []string) {
+	writeList(w, r, s.sortedEvents())
+}
+
+func (s *Server) getEvent(w http.ResponseWriter, _ *http.Request, params []string) {
+	event, _, ok := s.find(w, collectionEvents, params[0])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, event)
+}
+
+func (s *Server) markEventSeen(w http.ResponseWriter, _ *http.Request, params []string) {
+	_, eventID, ok := s.find(w, collectionEvents, params[0])
+	if !ok {
+		return
+	}
+
+	for _, event := range s.list(collectionEvents) {
+		if id := asInt(event["id"]); id <= eventID {
+			s.update(collectionEvents, id, object{"seen": true})
+		}
+	}
+
+	writeEmpty(w)
+}
+
+func entityURL(format string, args ...any) string {
+	return "/v4/" + fmt.Sprintf(format, args...)
+}
@@ -0,0 +1,281 @@
+package fakeapi
+
+import (
+	"net/http"
+	"slices"
+)
+
+// Type is a Linode plan served by the fake server.
+type Type struct {
+	ID       string
+	Class    string
+	Disk     int
+	Memory   int
+	VCPUs    int
+	Transfer int
+	Hourly   float64
+	Monthly  float64
+
+	// The region-specific prices of the plan
+	RegionPrices []RegionPrice
+}
+
+// RegionPrice is a region-specific price of a type served by the fake server.
+type RegionPrice struct {
+	Region  string
+	Hourly  float64
+	Monthly float64
+}
+
+// Types are the Linode plans known to the fake server.
+var Types = []Type{
+	{"g6-nanode-1", "nanode", 25600, 1024, 1, 1000, 0.0075, 5, []RegionPrice{
+		{"us-central", 0.009, 6},
+	}},
+	{"g6-standard-1", "standard", 51200, 2048, 1, 2000, 0.018, 12, []RegionPrice{
+		{"us-central", 0.0216, 14.4},
+	}},
+	{"g6-standard-2", "standard", 81920, 4096, 2, 4000, 0.036, 24, nil},
+	{"g6-dedicated-2", "dedicated", 81920, 4096, 2, 4000, 0.054, 36, nil},
+}
+
+// PricedType is a volume, NodeBalancer or LKE type served by the fake server.
+type PricedType struct {
+	ID           string
+	Label        string
+	Hourly       float64
+	Monthly      float64
+	RegionPrices []RegionPrice
+}
+
+// VolumeTypes are the volume types known to the fake server.
+// Volumes are priced per GB.
+var VolumeTypes = []PricedType{
+	{"volume", "Storage Volume", 0.00015, 0.1, []RegionPrice{
+		{"us-central", 0.00018, 0.12},
+	}},
+}
+
+// NodeBalancerTypes are the NodeBalancer types known to the fake server.
+var NodeBalancerTypes = []PricedType{
+	{"nodebalancer", "NodeBalancer", 0.015, 10, []RegionPrice{
+		{"us-central", 0.018, 12},
+	}},
+}
+
+// LKETypes are the LKE types known to the fake server.
+var LKETypes = []PricedType{
+	{"lke-sa", "LKE Standard Availability", 0, 0, nil},
+	{"lke-ha", "LKE High Availability", 0.09, 60, []RegionPrice{
+		{"us-central", 0.108, 72},
+	}},
+}
+
+// Region is a region served by the fake server.
+type Region struct {
+	ID           string
+	Capabilities []string
+
+	// The capabilities of the region that are unavailable to the account
+	Unavailable []string
+}
+
+// Regions are the regions known to the fake server.
+var Regions = []Region{
+	{"us-east", []string{
+		"Linodes", "Block Storage", "Cloud Firewall", "VPCs", "NodeBalancers",
+		"Kubernetes", "Placement Group", "Disk Encryption", "LA Disk Encryption",
+	}, nil},
+	{"us-southeast", []string{
+		"Linodes", "Cloud Firewall", "NodeBalancers",
+	}, nil},
+	{"us-central", []string{
+		"Linodes", "Block Storage", "Kubernetes", "LKE HA Control Planes",
+	}, []string{"Block Storage"}},
+}
+
+func (s *Server) registerCatalogRoutes() {
+	s.handle(http.MethodGet, "linode/types", s.listTypes)
+	s.handle(http.MethodGet, `linode/types/([\w-]+)`, s.getType)
+	s.handle(http.MethodGet, "volumes/types", s.listPricedTypes(VolumeTypes))
+	s.handle(http.MethodGet, "nodebalancers/types", s.listPricedTypes(NodeBalancerTypes))
+	s.handle(http.MethodGet, "lke/types", s.listPricedTypes(LKETypes))
+	s.handle(http.MethodGet, "regions", s.listRegions)
+	s.handle(http.MethodGet, `regions/([\w-]+)`, s.getRegion)
+	s.handle(http.MethodGet, `account/availability/([\w-]+)`, s.getAccountAvailability)
+}
+
+func lookupType(id string) (Type, bool) {
+	for _, t := range Types {
+		if t.ID == id {
+			return t, true
+		}
+	}
+
+	return Type{}, false
+}
+
+func (t Type) toObject() object {
+	return normalize(object{
+		"id":          t.ID,
+		"label":       t.ID,
+		"class":       t.Class,
+		"disk":        t.Disk,
+		"memory":      t.Memory,
+		"vcpus":       t.VCPUs,
+		"transfer":    t.Transfer,
+		"network_out": 1000,
+		"gpus":        0,
+		"price": object{
+			"hourly":  t.Hourly,
+			"monthly": t.Monthly,
+		},
+		"region_prices": regionPricesToObjects(t.RegionPrices, 1),
+		"addons": object{
+			"backups": object{
+				"price": object{
+					"hourly":  t.Hourly / 4,
+					"monthly": t.Monthly / 4,
+				},
+				"region_prices": regionPricesToObjects(t.RegionPrices, 0.25),
+			},
+		},
+		"successor": nil,
+	})
+}
+
+func (t PricedType) toObject() object {
+	return normalize(object{
+		"id":    t.ID,
+		"label": t.Label,
+		"price": object{
+			"hourly":  t.Hourly,
+			"monthly": t.Monthly,
+		},
+		"region_prices": regionPricesToObjects(t.RegionPrices, 1),
+		"transfer":      0,
+	})
+}
+
+// regionPricesToObjects returns the given region prices multiplied by the given factor.
+func regionPricesToObjects(prices []RegionPrice, factor float64) []any {
+	result := make([]any, len(prices))
+	for i, p := range prices {
+		result[i] = object{
+			"id":      p.Region,
+			"hourly":  p.Hourly * factor,
+			"monthly": p.Monthly * factor,
+		}
+	}
+
+	return result
+}
+
+func (t Type) specs() object {
+	return object{
+		"disk":     t.Disk,
+		"memory":   t.Memory,
+		"vcpus":    t.VCPUs,
+		"transfer": t.Transfer,
+		"gpus":     0,
+	}
+}
+
+func lookupRegion(id string) (Region, bool) {
+	for _, r := range Regions {
+		if r.ID == id {
+			return r, true
+		}
+	}
+
+	return Region{}, false
+}
+
+func (r Region) toObject() object {
+	return normalize(object{
+		"id":           r.ID,
+		"label":        r.ID,
+		"country":      "us",
+		"capabilities": r.Capabilities,
+		"status":       "ok",
+		"site_type":    "core",
+		"resolvers": object{
+			"ipv4": "192.0.2.1",
+			"ipv6": "2001:db8::1",
+		},
+		"placement_group_limits": object{
+			"maximum_pgs_per_customer": 100,
+			"maximum_linodes_per_pg":   5,
+		},
+	})
+}
+
+func (s *Server) listTypes(w http.ResponseWriter, r *http.Request, _ []string) {
+	result := make([]object, len(Types))
+	for i, t := range Types {
+		result[i] = t.toObject()
+	}
+
+	writeList(w, r, result)
+}
+
+func (s *Server) getType(w http.ResponseWriter, _ *http.Request, params []string) {
+	t, ok := lookupType(params[0])
+	if !ok {
+		writeError(w, http.StatusNotFound, "", "Not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, t.toObject())
+}
+
+func (s *Server) listPricedTypes(pricedTypes []PricedType) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ []string) {
+		result := make([]object, len(pricedTypes))
+		for i, t := range pricedTypes {
+			result[i] = t.toObject()
+		}
+
+		writeList(w, r, result)
+	}
+}
+
+func (s *Server) listRegions(w http.ResponseWriter, r *http.Request, _ []string) {
+	result := make([]object, len(Regions))
+	for i, region := range Regions {
+		result[i] = region.toObject()
+	}
+
+	writeList(w, r, result)
+}
+
+func (s *Server) getRegion(w http.ResponseWriter, _ *http.Request, params []string) {
+	region, ok := lookupRegion(params[0])
+	if !ok {
+		writeError(w, http.StatusNotFound, "", "Not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, region.toObject())
+}
+
+func (s *Server) getAccountAvailability(w http.ResponseWriter, _ *http.Request, params []string) {
+	region, ok := lookupRegion(params[0])
+	if !ok {
+		writeError(w, http.StatusNotFound, "", "Not found")
+		return
+	}
+
+	available := make([]string, 0, len(region.Capabilities))
+	for _, capability := range region.Capabilities {
+		if !slices.Contains(region.Unavailable, capability) {
+			available = append(available, capability)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, normalize(object{
+		"region":      region.ID,
+		"available":   available,
+		"unavailable": region.Unavailable,
+	}))
+}
@@ -0,0 +1,267 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const collectionDomains = "domains"
+
+func domainRecordsCollection(domainID int) string {
+	return fmt.Sprintf("%s/%d/records", collectionDomains, domainID)
+}
+
+func (s *Server) registerDomainRoutes() {
+	s.handle(http.MethodGet, "domains", s.listDomains)
+	s.handle(http.MethodPost, "domains", s.createDomain)
+	s.handle(http.MethodGet, `domains/(\d+)`, s.getDomain)
+	s.handle(http.MethodPut, `domains/(\d+)`, s.updateDomain)
+	s.handle(http.MethodDelete, `domains/(\d+)`, s.deleteDomain)
+	s.handle(http.MethodGet, `domains/(\d+)/zone-file`, s.getDomainZoneFile)
+
+	s.handle(http.MethodGet, `domains/(\d+)/records`, s.listDomainRecords)
+	s.handle(http.MethodPost, `domains/(\d+)/records`, s.createDomainRecord)
+	s.handle(http.MethodGet, `domains/(\d+)/records/(\d+)`, s.getDomainRecord)
+	s.handle(http.MethodPut, `domains/(\d+)/records/(\d+)`, s.updateDomainRecord)
+	s.handle(http.MethodDelete, `domains/(\d+)/records/(\d+)`, s.deleteDomainRecord)
+}
+
+func domainEntity(domain object) object {
+	id := asInt(domain["id"])
+	return entityRef("domain", id, asString(domain["domain"]), entityURL("domains/%d", id))
+}
+
+func (s *Server) listDomains(w http.ResponseWriter, r *http.Request, _ []string) {
+	writeList(w, r, s.list(collectionDomains))
+}
+
+func (s *Server) getDomain(w http.ResponseWriter, _ *http.Request, params []string) {
+	domain, _, ok := s.find(w, collectionDomains, params[0])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, domain)
+}
+
+func (s *Server) createDomain(w http.ResponseWriter, r *http.Request, _ []string) {
+	body := readBody(r)
+
+	name := asString(body["domain"])
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "domain", "domain is required")
+		return
+	}
+
+	for _, existing := range s.list(collectionDomains) {
+		if existing["domain"] == name {
+			writeError(w, http.StatusBadRequest, "domain", "Domain already exists")
+			return
+		}
+	}
+
+	domainType := asString(body["type"])
+	if domainType == "master" && asString(body["soa_email"]) == "" {
+		writeError(w, http.StatusBadRequest, "soa_email", "soa_email is required for master domains")
+		return
+	}
+
+	domain := object{
+		"domain":      name,
+		"type":        domainType,
+		"status":      "active",
+		"group":       "",
+		"description": "",
+		"soa_email":   "",
+		"master_ips":  []any{},
+		"axfr_ips":    []any{},
+		"tags":        []any{},
+		"ttl_sec":     0,
+		"retry_sec":   0,
+		"expire_sec":  0,
+		"refresh_sec": 0,
+	}
+
+	for k, v := range body {
+		if _, ok := domain[k]; ok && v != nil {
+			domain[k] = v
+		}
+	}
+
+	domain = s.insert(collectionDomains, domain)
+	s.addEvent("domain_create", domainEntity(domain), nil)
+
+	writeJSON(w, http.StatusOK, domain)
+}
+
+func (s *Server) updateDomain(w http.ResponseWriter, r *http.Request, params []string) {
+	domain, id, ok := s.find(w, collectionDomains, params[0])
+	if !ok {
+		return
+	}
+
+	update := object{}
+	for k, v := range readBody(r) {
+		if _, ok := domain[k]; ok && k != "id" {
+			update[k] = v
+		}
+	}
+
+	domain = s.update(collectionDomains, id, update)
+	s.addEvent("domain_update", domainEntity(domain), nil)
+
+	writeJSON(w, http.StatusOK, domain)
+}
+
+func (s *Server) deleteDomain(w http.ResponseWriter, _ *http.Request, params []string) {
+	domain, id, ok := s.find(w, collectionDomains, params[0])
+	if !ok {
+		return
+	}
+
+	s.remove(collectionDomains, id)
+	s.addEvent("domain_delete", domainEntity(domain), nil)
+
+	writeEmpty(w)
+}
+
+// getDomainZoneFile renders a minimal BIND zone for the domain and its records.
+func (s *Server) getDomainZoneFile(w http.ResponseWriter, _ *http.Request, params []string) {
+	domain, id, ok := s.find(w, collectionDomains, params[0])
+	if !ok {
+		return
+	}
+
+	name := asString(domain["domain"])
+	lines := []any{
+		fmt.Sprintf("; %s [%d]", name, id),
+		fmt.Sprintf("$TTL %d", max(asInt(domain["ttl_sec"]), 86400)),
+		fmt.Sprintf("@  IN  SOA  ns1.linode.com. %s. 2021000001 14400 14400 1209600 86400", asString(domain["soa_email"])),
+		"@    NS  ns1.linode.com.",
+		"@    NS  ns2.linode.com.",
+	}
+
+	for _, record := range s.list(domainRecordsCollection(id)) {
+		recordName := asString(record["name"])
+		if recordName == "" {
+			recordName = "@"
+		}
+
+		target := asString(record["target"])
+
+		switch record["type"] {
+		case "MX":
+			lines = append(lines, fmt.Sprintf("%s  MX  %d  %s.", recordName, asInt(record["priority"]), target))
+		case "TXT":
+			lines = append(lines, fmt.Sprintf("%s  TXT  %q", recordName, target))
+		case "CNAME":
+			lines = append(lines, fmt.Sprintf("%s  CNAME  %s.", recordName, target))
+		default:
+			lines = append(lines, fmt.Sprintf("%s  %s  %s", recordName, asString(record["type"]), target))
+		}
+	}
+
+	writeJSON(w, http.StatusOK, object{"zone_file": lines})
+}
+
+func (s *Server) listDomainRecords(w http.ResponseWriter, r *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionDomains, params[0])
+	if !ok {
+		return
+	}
+
+	writeList(w, r, s.list(domainRecordsCollection(id)))
+}
+
+func (s *Server) getDomainRecord(w http.ResponseWriter, _ *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionDomains, params[0])
+	if !ok {
+		return
+	}
+
+	record, _, ok := s.find(w, domainRecordsCollection(id), params[1])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, record)
+}
+
+func (s *Server) createDomainRecord(w http.ResponseWriter, r *http.Request, params []string) {
+	domain, id, ok := s.find(w, collectionDomains, params[0])
+	if !ok {
+		return
+	}
+
+	body := readBody(r)
+
+	if asString(body["type"]) == "" {
+		writeError(w, http.StatusBadRequest, "type", "type is required")
+		return
+	}
+
+	record := object{
+		"type":     "",
+		"name":     "",
+		"target":   "",
+		"priority": 0,
+		"weight":   0,
+		"port":     0,
+		"service":  nil,
+		"protocol": nil,
+		"ttl_sec":  0,
+		"tag":      nil,
+	}
+
+	for k, v := range body {
+		if _, ok := record[k]; ok {
+			record[k] = v
+		}
+	}
+
+	record = s.insert(domainRecordsCollection(id), record)
+	s.addEvent("domain_record_create", domainEntity(domain), entityRef("domain_record", asInt(record["id"]), "", ""))
+
+	writeJSON(w, http.StatusOK, record)
+}
+
+func (s *Server) updateDomainRecord(w http.ResponseWriter, r *http.Request, params []string) {
+	domain, id, ok := s.find(w, collectionDomains, params[0])
+	if !ok {
+		return
+	}
+
+	record, recordID, ok := s.find(w, domainRecordsCollection(id), params[1])
+	if !ok {
+		return
+	}
+
+	update := object{}
+	for k, v := range readBody(r) {
+		if _, ok := record[k]; ok && k != "id" {
+			update[k] = v
+		}
+	}
+
+	record = s.update(domainRecordsCollection(id), recordID, update)
+	s.addEvent("domain_record_update", domainEntity(domain), entityRef("domain_record", recordID, "", ""))
+
+	writeJSON(w, http.StatusOK, record)
+}
+
+func (s *Server) deleteDomainRecord(w http.ResponseWriter, _ *http.Request, params []string) {
+	domain, id, ok := s.find(w, collectionDomains, params[0])
+	if !ok {
+		return
+	}
+
+	_, recordID, ok := s.find(w, domainRecordsCollection(id), params[1])
+	if !ok {
+		return
+	}
+
+	s.remove(domainRecordsCollection(id), recordID)
+	s.addEvent("domain_record_delete", domainEntity(domain), entityRef("domain_record", recordID, "", ""))
+
+	writeEmpty(w)
+}
@@ -0,0 +1,287 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const collectionFirewalls = "networking/firewalls"
+
+func firewallDevicesCollection(firewallID int) string {
+	return fmt.Sprintf("%s/%d/devices", collectionFirewalls, firewallID)
+}
+
+func (s *Server) registerFirewallRoutes() {
+	s.handle(http.MethodGet, "networking/firewalls", s.listFirewalls)
+	s.handle(http.MethodPost, "networking/firewalls", s.createFirewall)
+	s.handle(http.MethodGet, `networking/firewalls/(\d+)`, s.getFirewall)
+	s.handle(http.MethodPut, `networking/firewalls/(\d+)`, s.updateFirewall)
+	s.handle(http.MethodDelete, `networking/firewalls/(\d+)`, s.deleteFirewall)
+
+	s.handle(http.MethodGet, `networking/firewalls/(\d+)/rules`, s.getFirewallRules)
+	s.handle(http.MethodPut, `networking/firewalls/(\d+)/rules`, s.updateFirewallRules)
+
+	s.handle(http.MethodGet, `networking/firewalls/(\d+)/devices`, s.listFirewallDevices)
+	s.handle(http.MethodPost, `networking/firewalls/(\d+)/devices`, s.createFirewallDevice)
+	s.handle(http.MethodGet, `networking/firewalls/(\d+)/devices/(\d+)`, s.getFirewallDevice)
+	s.handle(http.MethodDelete, `networking/firewalls/(\d+)/devices/(\d+)`, s.deleteFirewallDevice)
+}
+
+func firewallEntity(firewall object) object {
+	id := asInt(firewall["id"])
+	return entityRef("firewall", id, asString(firewall["label"]), entityURL("networking/firewalls/%d", id))
+}
+
+// firewallRules normalizes a rule set, filling in the fields
+// computed by the API.
+func firewallRules(rules object, version int) object {
+	result := object{
+		"inbound":         []any{},
+		"inbound_policy":  "ACCEPT",
+		"outbound":        []any{},
+		"outbound_policy": "ACCEPT",
+	}
+
+	for k, v := range rules {
+		if v != nil {
+			result[k] = v
+		}
+	}
+
+	result["version"] = version
+	result["fingerprint"] = fmt.Sprintf("%08x", version)
+
+	return result
+}
+
+// firewallEntities returns the entity references of all devices of a firewall.
+func (s *Server) firewallEntities(firewallID int) []any {
+	result := []any{}
+
+	for _, device := range s.list(firewallDevicesCollection(firewallID)) {
+		result = append(result, device["entity"])
+	}
+
+	return result
+}
+
+func (s *Server) firewallsForEntity(entityType string, entityID int) []object {
+	result := []object{}
+
+	for _, firewall := range s.list(collectionFirewalls) {
+		for _, device := range s.list(firewallDevicesCollection(asInt(firewall["id"]))) {
+			entity := device["entity"].(object)
+			if entity["type"] == entityType && asInt(entity["id"]) == entityID {
+				result = append(result, firewall)
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+func (s *Server) attachFirewallDevice(firewall object, entityType string, entityID int) object {
+	firewallID := asInt(firewall["id"])
+
+	var entity object
+
+	switch entityType {
+	case "linode":
+		inst, _ := s.lookup(collectionInstances, entityID)
+		entity = linodeEntity(inst)
+	default:
+		entity = entityRef(entityType, entityID, "", "")
+	}
+
+	device := s.insert(firewallDevicesCollection(firewallID), object{"entity": entity})
+	s.update(collectionFirewalls, firewallID, object{"entities": s.firewallEntities(firewallID)})
+	s.addEvent("firewall_device_add", firewallEntity(firewall), entity)
+
+	return device
+}
+
+// detachFirewallDevices removes the given entity from all firewalls.
+func (s *Server) detachFirewallDevices(entityType string, entityID int) {
+	for _, firewall := range s.list(collectionFirewalls) {
+		firewallID := asInt(firewall["id"])
+
+		for _, device := range s.list(firewallDevicesCollection(firewallID)) {
+			entity := device["entity"].(object)
+			if entity["type"] == entityType && asInt(entity["id"]) == entityID {
+				s.remove(firewallDevicesCollection(firewallID), asInt(device["id"]))
+			}
+		}
+
+		s.update(collectionFirewalls, firewallID, object{"entities": s.firewallEntities(firewallID)})
+	}
+}
+
+func (s *Server) listFirewalls(w http.ResponseWriter, r *http.Request, _ []string) {
+	writeList(w, r, s.list(collectionFirewalls))
+}
+
+func (s *Server) getFirewall(w http.ResponseWriter, _ *http.Request, params []string) {
+	firewall, _, ok := s.find(w, collectionFirewalls, params[0])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, firewall)
+}
+
+func (s *Server) createFirewall(w http.ResponseWriter, r *http.Request, _ []string) {
+	body := readBody(r)
+
+	label := asString(body["label"])
+	if label == "" {
+		writeError(w, http.StatusBadRequest, "label", "label is required")
+		return
+	}
+
+	rules, _ := body["rules"].(object)
+
+	tags := asList(body["tags"])
+	if tags == nil {
+		tags = []any{}
+	}
+
+	firewall := s.insert(collectionFirewalls, object{
+		"label":    label,
+		"status":   "enabled",
+		"rules":    firewallRules(rules, 1),
+		"tags":     tags,
+		"entities": []any{},
+	})
+	s.addEvent("firewall_create", firewallEntity(firewall), nil)
+
+	if devices, ok := body["devices"].(object); ok {
+		for _, id := range asList(devices["linodes"]) {
+			s.attachFirewallDevice(firewall, "linode", asInt(id))
+		}
+
+		for _, id := range asList(devices["nodebalancers"]) {
+			s.attachFirewallDevice(firewall, "nodebalancer", asInt(id))
+		}
+	}
+
+	firewall, _ = s.lookup(collectionFirewalls, asInt(firewall["id"]))
+
+	writeJSON(w, http.StatusOK, firewall)
+}
+
+func (s *Server) updateFirewall(w http.ResponseWriter, r *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionFirewalls, params[0])
+	if !ok {
+		return
+	}
+
+	body := readBody(r)
+	update := object{}
+
+	for _, field := range []string{"label", "status", "tags"} {
+		if v, ok := body[field]; ok {
+			update[field] = v
+		}
+	}
+
+	writeJSON(w, http.StatusOK, s.update(collectionFirewalls, id, update))
+}
+
+func (s *Server) deleteFirewall(w http.ResponseWriter, _ *http.Request, params []string) {
+	firewall, id, ok := s.find(w, collectionFirewalls, params[0])
+	if !ok {
+		return
+	}
+
+	s.remove(collectionFirewalls, id)
+	s.addEvent("firewall_delete", firewallEntity(firewall), nil)
+
+	writeEmpty(w)
+}
+
+func (s *Server) getFirewallRules(w http.ResponseWriter, _ *http.Request, params []string) {
+	firewall, _, ok := s.find(w, collectionFirewalls, params[0])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, firewall["rules"])
+}
+
+func (s *Server) updateFirewallRules(w http.ResponseWriter, r *http.Request, params []string) {
+	firewall, id, ok := s.find(w, collectionFirewalls, params[0])
+	if !ok {
+		return
+	}
+
+	version := asInt(firewall["rules"].(object)["version"]) + 1
+	firewall = s.update(collectionFirewalls, id, object{
+		"rules": firewallRules(readBody(r), version),
+	})
+	s.addEvent("firewall_rules_update", firewallEntity(firewall), nil)
+
+	writeJSON(w, http.StatusOK, firewall["rules"])
+}
+
+func (s *Server) listFirewallDevices(w http.ResponseWriter, r *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionFirewalls, params[0])
+	if !ok {
+		return
+	}
+
+	writeList(w, r, s.list(firewallDevicesCollection(id)))
+}
+
+func (s *Server) getFirewallDevice(w http.ResponseWriter, _ *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionFirewalls, params[0])
+	if !ok {
+		return
+	}
+
+	device, _, ok := s.find(w, firewallDevicesCollection(id), params[1])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, device)
+}
+
+func (s *Server) createFirewallDevice(w http.ResponseWriter, r *http.Request, params []string) {
+	firewall, _, ok := s.find(w, collectionFirewalls, params[0])
+	if !ok {
+		return
+	}
+
+	body := readBody(r)
+
+	entityType := asString(body["type"])
+	entityID := asInt(body["id"])
+
+	if entityType == "linode" {
+		if _, ok := s.lookup(collectionInstances, entityID); !ok {
+			writeError(w, http.StatusBadRequest, "id", "Linode not found")
+			return
+		}
+	}
+
+	writeJSON(w, http.StatusOK, s.attachFirewallDevice(firewall, entityType, entityID))
+}
+
+func (s *Server) deleteFirewallDevice(w http.ResponseWriter, _ *http.Request, params []string) {
+	firewall, id, ok := s.find(w, collectionFirewalls, params[0])
+	if !ok {
+		return
+	}
+
+	device, deviceID, ok := s.find(w, firewallDevicesCollection(id), params[1])
+	if !ok {
+		return
+	}
+
+	s.remove(firewallDevicesCollection(id), deviceID)
+	s.update(collectionFirewalls, id, object{"entities": s.firewallEntities(id)})
+	s.addEvent("firewall_device_remove", firewallEntity(firewall), device["entity"].(object))
+
+	writeEmpty(w)
+}
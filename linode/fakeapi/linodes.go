@@ -0,0 +1,833 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	collectionInstances = "linode/instances"
+
+	defaultSwapSize = 512
+)
+
+func instanceDisksCollection(linodeID int) string {
+	return fmt.Sprintf("%s/%d/disks", collectionInstances, linodeID)
+}
+
+func instanceConfigsCollection(linodeID int) string {
+	return fmt.Sprintf("%s/%d/configs", collectionInstances, linodeID)
+}
+
+func (s *Server) registerLinodeRoutes() {
+	s.handle(http.MethodGet, "linode/instances", s.listInstances)
+	s.handle(http.MethodPost, "linode/instances", s.createInstance)
+	s.handle(http.MethodGet, `linode/instances/(\d+)`, s.getInstance)
+	s.handle(http.MethodPut, `linode/instances/(\d+)`, s.updateInstance)
+	s.handle(http.MethodDelete, `linode/instances/(\d+)`, s.deleteInstance)
+
+	s.handle(http.MethodPost, `linode/instances/(\d+)/boot`, s.instanceStatusAction("linode_boot", "running"))
+	s.handle(http.MethodPost, `linode/instances/(\d+)/reboot`, s.instanceStatusAction("linode_reboot", "running"))
+	s.handle(http.MethodPost, `linode/instances/(\d+)/shutdown`, s.instanceStatusAction("linode_shutdown", "offline"))
+	s.handle(http.MethodPost, `linode/instances/(\d+)/resize`, s.resizeInstance)
+	s.handle(http.MethodPost, `linode/instances/(\d+)/migrate`, s.migrateInstance)
+	s.handle(http.MethodPost, `linode/instances/(\d+)/rebuild`, s.rebuildInstance)
+	s.handle(http.MethodPost, `linode/instances/(\d+)/backups/enable`, s.setInstanceBackups(true))
+	s.handle(http.MethodPost, `linode/instances/(\d+)/backups/cancel`, s.setInstanceBackups(false))
+
+	s.handle(http.MethodGet, `linode/instances/(\d+)/ips`, s.getInstanceIPs)
+	s.handle(http.MethodPost, `linode/instances/(\d+)/ips`, s.addInstanceIP)
+	s.handle(http.MethodGet, `linode/instances/(\d+)/volumes`, s.listInstanceVolumes)
+	s.handle(http.MethodGet, `linode/instances/(\d+)/firewalls`, s.listInstanceFirewalls)
+
+	s.handle(http.MethodGet, `linode/instances/(\d+)/disks`, s.listInstanceDisks)
+	s.handle(http.MethodPost, `linode/instances/(\d+)/disks`, s.createInstanceDisk)
+	s.handle(http.MethodGet, `linode/instances/(\d+)/disks/(\d+)`, s.getInstanceDisk)
+	s.handle(http.MethodPut, `linode/instances/(\d+)/disks/(\d+)`, s.updateInstanceDisk)
+	s.handle(http.MethodDelete, `linode/instances/(\d+)/disks/(\d+)`, s.deleteInstanceDisk)
+	s.handle(http.MethodPost, `linode/instances/(\d+)/disks/(\d+)/resize`, s.resizeInstanceDisk)
+
+	s.handle(http.MethodGet, `linode/instances/(\d+)/configs`, s.listInstanceConfigs)
+	s.handle(http.MethodPost, `linode/instances/(\d+)/configs`, s.createInstanceConfig)
+	s.handle(http.MethodGet, `linode/instances/(\d+)/configs/(\d+)`, s.getInstanceConfig)
+	s.handle(http.MethodPut, `linode/instances/(\d+)/configs/(\d+)`, s.updateInstanceConfig)
+	s.handle(http.MethodDelete, `linode/instances/(\d+)/configs/(\d+)`, s.deleteInstanceConfig)
+}
+
+func linodeEntity(inst object) object {
+	id := asInt(inst["id"])
+	return entityRef("linode", id, asString(inst["label"]), entityURL("linode/instances/%d", id))
+}
+
+func diskEntity(disk object) object {
+	id := asInt(disk["id"])
+	return entityRef("disk", id, asString(disk["label"]), "")
+}
+
+func configEntity(config object) object {
+	id := asInt(config["id"])
+	return entityRef("linode_config", id, asString(config["label"]), "")
+}
+
+// allocateAddress returns a new unique documentation-range IPv4 address.
+func (s *Server) allocateAddress(private bool) string {
+	s.nextAddress++
+
+	if private {
+		return fmt.Sprintf("192.168.%d.%d", 128+s.nextAddress/254, s.nextAddress%254+1)
+	}
+
+	return fmt.Sprintf("198.51.%d.%d", s.nextAddress/254, s.nextAddress%254+1)
+}
+
+func (s *Server) listInstances(w http.ResponseWriter, r *http.Request, _ []string) {
+	writeList(w, r, s.list(collectionInstances))
+}
+
+func (s *Server) getInstance(w http.ResponseWriter, _ *http.Request, params []string) {
+	inst, _, ok := s.find(w, collectionInstances, params[0])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, inst)
+}
+
+func (s *Server) createInstance(w http.ResponseWriter, r *http.Request, _ []string) {
+	body := readBody(r)
+
+	region := asString(body["region"])
+	if _, ok := lookupRegion(region); !ok {
+		writeError(w, http.StatusBadRequest, "region", "region is not valid")
+		return
+	}
+
+	linodeType, ok := lookupType(asString(body["type"]))
+	if !ok {
+		writeError(w, http.StatusBadRequest, "type", "A valid plan type by that ID was not found")
+		return
+	}
+
+	image := asString(body["image"])
+	if image != "" && asString(body["root_pass"]) == "" {
+		writeError(w, http.StatusBadRequest, "root_pass", "root_pass is required when deploying an image")
+		return
+	}
+
+	// Instances without an image have nothing to boot from
+	booted := image != ""
+	if v, ok := body["booted"].(bool); ok {
+		booted = v && booted
+	}
+
+	status := "offline"
+	if booted {
+		status = "running"
+	}
+
+	ipv4 := []any{s.allocateAddress(false)}
+	if privateIP, _ := body["private_ip"].(bool); privateIP {
+		ipv4 = append(ipv4, s.allocateAddress(true))
+	}
+
+	tags := asList(body["tags"])
+	if tags == nil {
+		tags = []any{}
+	}
+
+	var imageValue any
+	if image != "" {
+		imageValue = image
+	}
+
+	var placementGroup any
+	if pg, ok := body["placement_group"].(object); ok {
+		placementGroup = object{"id": pg["id"]}
+	}
+
+	backupsEnabled, _ := body["backups_enabled"].(bool)
+
+	diskEncryption := asString(body["disk_encryption"])
+	if diskEncryption == "" {
+		diskEncryption = "disabled"
+	}
+
+	userData := ""
+	if metadata, ok := body["metadata"].(object); ok {
+		userData = asString(metadata["user_data"])
+	}
+
+	inst := s.insert(collectionInstances, object{
+		"label":  asString(body["label"]),
+		"group":  asString(body["group"]),
+		"region": region,
+		"type":   linodeType.ID,
+		"image":  imageValue,
+		"status": status,
+		"ipv4":   ipv4,
+		"specs":  linodeType.specs(),
+		"alerts": object{
+			"cpu":            90,
+			"io":             10000,
+			"network_in":     10,
+			"network_out":    10,
+			"transfer_quota": 80,
+		},
+		"backups": object{
+			"enabled":   backupsEnabled,
+			"available": false,
+			"schedule": object{
+				"day":    "Scheduling",
+				"window": "Scheduling",
+			},
+			"last_successful": nil,
+		},
+		"hypervisor":       "kvm",
+		"host_uuid":        "fakeapi",
+		"watchdog_enabled": true,
+		"tags":             tags,
+		"has_user_data":    userData != "",
+		"placement_group":  placementGroup,
+		"disk_encryption":  diskEncryption,
+		"lke_cluster_id":   0,
+	})
+
+	id := asInt(inst["id"])
+
+	update := object{
+		"ipv6": fmt.Sprintf("2001:db8::%x/128", id),
+	}
+	if inst["label"] == "" {
+		update["label"] = fmt.Sprintf("linode%d", id)
+	}
+
+	inst = s.update(collectionInstances, id, update)
+
+	if image != "" {
+		swapSize := defaultSwapSize
+		if v, ok := body["swap_size"]; ok {
+			swapSize = asInt(v)
+		}
+
+		s.createImplicitDisks(id, image, linodeType.Disk, swapSize, body["interfaces"])
+	}
+
+	if firewallID := asInt(body["firewall_id"]); firewallID != 0 {
+		if firewall, ok := s.lookup(collectionFirewalls, firewallID); ok {
+			s.attachFirewallDevice(firewall, "linode", id)
+		}
+	}
+
+	s.addEvent("linode_create", linodeEntity(inst), nil)
+
+	if booted {
+		s.addEvent("linode_boot", linodeEntity(inst), nil)
+	}
+
+	writeJSON(w, http.StatusOK, inst)
+}
+
+// createImplicitDisks creates the disks and config the API
+// creates when deploying an image to an instance.
+func (s *Server) createImplicitDisks(id int, image string, diskSize, swapSize int, interfaces any) {
+	devices := object{}
+
+	disk := s.insert(instanceDisksCollection(id), newDisk(image+" Disk", diskSize-swapSize, "ext4"))
+	devices["sda"] = object{"disk_id": disk["id"], "volume_id": nil}
+
+	if swapSize > 0 {
+		swap := s.insert(instanceDisksCollection(id), newDisk(image+" Swap Disk", swapSize, "swap"))
+		devices["sdb"] = object{"disk_id": swap["id"], "volume_id": nil}
+	}
+
+	s.insert(instanceConfigsCollection(id), s.newConfig(object{
+		"label":      fmt.Sprintf("My %s Disk Profile", image),
+		"devices":    devices,
+		"interfaces": interfaces,
+	}))
+}
+
+func (s *Server) rebuildInstance(w http.ResponseWriter, r *http.Request, params []string) {
+	inst, id, ok := s.find(w, collectionInstances, params[0])
+	if !ok {
+		return
+	}
+
+	body := readBody(r)
+
+	image := asString(body["image"])
+	if image == "" {
+		writeError(w, http.StatusBadRequest, "image", "image is required")
+		return
+	}
+
+	if asString(body["root_pass"]) == "" {
+		writeError(w, http.StatusBadRequest, "root_pass", "root_pass is required")
+		return
+	}
+
+	linodeType, _ := lookupType(asString(inst["type"]))
+
+	booted := true
+	if v, ok := body["booted"].(bool); ok {
+		booted = v
+	}
+
+	status := "offline"
+	if booted {
+		status = "running"
+	}
+
+	// Rebuilding deletes all disks and configs of the instance
+	for _, disk := range s.list(instanceDisksCollection(id)) {
+		s.remove(instanceDisksCollection(id), asInt(disk["id"]))
+	}
+
+	for _, config := range s.list(instanceConfigsCollection(id)) {
+		s.remove(instanceConfigsCollection(id), asInt(config["id"]))
+	}
+
+	s.createImplicitDisks(id, image, linodeType.Disk, defaultSwapSize, nil)
+
+	userData := ""
+	if metadata, ok := body["metadata"].(object); ok {
+		userData = asString(metadata["user_data"])
+	}
+
+	inst = s.update(collectionInstances, id, object{
+		"image":         image,
+		"status":        status,
+		"has_user_data": userData != "",
+	})
+
+	s.addEvent("linode_rebuild", linodeEntity(inst), nil)
+
+	if booted {
+		s.addEvent("linode_boot", linodeEntity(inst), nil)
+	}
+
+	writeJSON(w, http.StatusOK, inst)
+}
+
+func (s *Server) updateInstance(w http.ResponseWriter, r *http.Request, params []string) {
+	inst, id, ok := s.find(w, collectionInstances, params[0])
+	if !ok {
+		return
+	}
+
+	body := readBody(r)
+	update := object{}
+
+	for _, field := range []string{"label", "group", "tags", "watchdog_enabled"} {
+		if v, ok := body[field]; ok {
+			update[field] = v
+		}
+	}
+
+	if alerts, ok := body["alerts"].(object); ok {
+		merged := inst["alerts"].(object)
+		for k, v := range alerts {
+			merged[k] = v
+		}
+		update["alerts"] = merged
+	}
+
+	writeJSON(w, http.StatusOK, s.update(collectionInstances, id, update))
+}
+
+func (s *Server) deleteInstance(w http.ResponseWriter, _ *http.Request, params []string) {
+	inst, id, ok := s.find(w, collectionInstances, params[0])
+	if !ok {
+		return
+	}
+
+	for _, volume := range s.list(collectionVolumes) {
+		if asInt(volume["linode_id"]) == id {
+			s.update(collectionVolumes, asInt(volume["id"]), object{"linode_id": nil, "linode_label": nil})
+		}
+	}
+
+	s.detachFirewallDevices("linode", id)
+	s.remove(collectionInstances, id)
+	s.addEvent("linode_delete", linodeEntity(inst), nil)
+
+	writeEmpty(w)
+}
+
+// instanceStatusAction handles the boot, reboot and shutdown endpoints.
+func (s *Server) instanceStatusAction(action, status string) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params []string) {
+		inst, id, ok := s.find(w, collectionInstances, params[0])
+		if !ok {
+			return
+		}
+
+		if status == "running" && len(s.list(instanceConfigsCollection(id))) == 0 {
+			writeError(w, http.StatusBadRequest, "", "Linode has no configs to boot from")
+			return
+		}
+
+		inst = s.update(collectionInstances, id, object{"status": status})
+		s.addEvent(action, linodeEntity(inst), nil)
+
+		writeEmpty(w)
+	}
+}
+
+func (s *Server) resizeInstance(w http.ResponseWriter, r *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionInstances, params[0])
+	if !ok {
+		return
+	}
+
+	linodeType, ok := lookupType(asString(readBody(r)["type"]))
+	if !ok {
+		writeError(w, http.StatusBadRequest, "type", "A valid plan type by that ID was not found")
+		return
+	}
+
+	if s.instanceDiskUsage(id, 0) > linodeType.Disk {
+		writeError(w, http.StatusBadRequest, "", "Linode's disks must fit within the new plan's allocation")
+		return
+	}
+
+	inst := s.update(collectionInstances, id, object{
+		"type":  linodeType.ID,
+		"specs": linodeType.specs(),
+	})
+	s.addEvent("linode_resize", linodeEntity(inst), nil)
+
+	writeEmpty(w)
+}
+
+func (s *Server) migrateInstance(w http.ResponseWriter, r *http.Request, params []string) {
+	inst, id, ok := s.find(w, collectionInstances, params[0])
+	if !ok {
+		return
+	}
+
+	if region := asString(readBody(r)["region"]); region != "" {
+		if _, ok := lookupRegion(region); !ok {
+			writeError(w, http.StatusBadRequest, "region", "region is not valid")
+			return
+		}
+
+		inst = s.update(collectionInstances, id, object{"region": region})
+	}
+
+	s.addEvent("linode_migrate_datacenter", linodeEntity(inst), nil)
+
+	writeEmpty(w)
+}
+
+func (s *Server) setInstanceBackups(enabled bool) handlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request, params []string) {
+		inst, id, ok := s.find(w, collectionInstances, params[0])
+		if !ok {
+			return
+		}
+
+		backups := inst["backups"].(object)
+		backups["enabled"] = enabled
+		inst = s.update(collectionInstances, id, object{"backups": backups})
+
+		action := "backups_cancel"
+		if enabled {
+			action = "backups_enable"
+		}
+		s.addEvent(action, linodeEntity(inst), nil)
+
+		writeEmpty(w)
+	}
+}
+
+func instanceIP(inst object, address string) object {
+	private := strings.HasPrefix(address, "192.168.")
+	ipType := "ipv4"
+	gateway := ""
+
+	switch {
+	case strings.Contains(address, ":"):
+		ipType = "ipv6"
+		gateway = "fe80::1"
+	case !private:
+		gateway = address[:strings.LastIndex(address, ".")] + ".1"
+	}
+
+	return object{
+		"address":     address,
+		"gateway":     gateway,
+		"subnet_mask": "255.255.255.0",
+		"prefix":      24,
+		"type":        ipType,
+		"public":      !private,
+		"rdns":        "",
+		"linode_id":   inst["id"],
+		"region":      inst["region"],
+		"vpc_nat_1_1": nil,
+	}
+}
+
+func (s *Server) getInstanceIPs(w http.ResponseWriter, _ *http.Request, params []string) {
+	inst, _, ok := s.find(w, collectionInstances, params[0])
+	if !ok {
+		return
+	}
+
+	public := []any{}
+	private := []any{}
+
+	for _, address := range asList(inst["ipv4"]) {
+		ip := instanceIP(inst, asString(address))
+		if ip["public"].(bool) {
+			public = append(public, ip)
+		} else {
+			private = append(private, ip)
+		}
+	}
+
+	slaac := instanceIP(inst, strings.TrimSuffix(asString(inst["ipv6"]), "/128"))
+	slaac["prefix"] = 64
+
+	writeJSON(w, http.StatusOK, object{
+		"ipv4": object{
+			"public":   public,
+			"private":  private,
+			"shared":   []any{},
+			"reserved": []any{},
+			"vpc":      []any{},
+		},
+		"ipv6": object{
+			"link_local": nil,
+			"slaac":      slaac,
+			"global":     []any{},
+		},
+	})
+}
+
+func (s *Server) addInstanceIP(w http.ResponseWriter, r *http.Request, params []string) {
+	inst, id, ok := s.find(w, collectionInstances, params[0])
+	if !ok {
+		return
+	}
+
+	public, _ := readBody(r)["public"].(bool)
+	address := s.allocateAddress(!public)
+
+	inst = s.update(collectionInstances, id, object{
+		"ipv4": append(asList(inst["ipv4"]), address),
+	})
+
+	writeJSON(w, http.StatusOK, instanceIP(inst, address))
+}
+
+func (s *Server) listInstanceVolumes(w http.ResponseWriter, r *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionInstances, params[0])
+	if !ok {
+		return
+	}
+
+	result := []object{}
+	for _, volume := range s.list(collectionVolumes) {
+		if asInt(volume["linode_id"]) == id {
+			result = append(result, volume)
+		}
+	}
+
+	writeList(w, r, result)
+}
+
+func (s *Server) listInstanceFirewalls(w http.ResponseWriter, r *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionInstances, params[0])
+	if !ok {
+		return
+	}
+
+	writeList(w, r, s.firewallsForEntity("linode", id))
+}
+
+func newDisk(label string, size int, filesystem string) object {
+	return object{
+		"label":           label,
+		"status":          "ready",
+		"size":            size,
+		"filesystem":      filesystem,
+		"disk_encryption": "disabled",
+	}
+}
+
+// instanceDiskUsage returns the total size of an instance's disks,
+// optionally excluding the disk with the given ID.
+func (s *Server) instanceDiskUsage(linodeID, excludeDiskID int) int {
+	total := 0
+
+	for _, disk := range s.list(instanceDisksCollection(linodeID)) {
+		if asInt(disk["id"]) != excludeDiskID {
+			total += asInt(disk["size"])
+		}
+	}
+
+	return total
+}
+
+func (s *Server) listInstanceDisks(w http.ResponseWriter, r *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionInstances, params[0])
+	if !ok {
+		return
+	}
+
+	writeList(w, r, s.list(instanceDisksCollection(id)))
+}
+
+func (s *Server) getInstanceDisk(w http.ResponseWriter, _ *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionInstances, params[0])
+	if !ok {
+		return
+	}
+
+	disk, _, ok := s.find(w, instanceDisksCollection(id), params[1])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, disk)
+}
+
+func (s *Server) createInstanceDisk(w http.ResponseWriter, r *http.Request, params []string) {
+	inst, id, ok := s.find(w, collectionInstances, params[0])
+	if !ok {
+		return
+	}
+
+	body := readBody(r)
+
+	size := asInt(body["size"])
+	if size < 1 {
+		writeError(w, http.StatusBadRequest, "size", "size must be a positive integer")
+		return
+	}
+
+	if s.instanceDiskUsage(id, 0)+size > asInt(inst["specs"].(object)["disk"]) {
+		writeError(w, http.StatusBadRequest, "size", "Insufficient space to create a disk of this size")
+		return
+	}
+
+	filesystem := asString(body["filesystem"])
+	if filesystem == "" {
+		filesystem = "ext4"
+	}
+
+	disk := s.insert(instanceDisksCollection(id), newDisk(asString(body["label"]), size, filesystem))
+	s.addEvent("disk_create", linodeEntity(inst), diskEntity(disk))
+
+	writeJSON(w, http.StatusOK, disk)
+}
+
+func (s *Server) updateInstanceDisk(w http.ResponseWriter, r *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionInstances, params[0])
+	if !ok {
+		return
+	}
+
+	_, diskID, ok := s.find(w, instanceDisksCollection(id), params[1])
+	if !ok {
+		return
+	}
+
+	update := object{}
+	if label, ok := readBody(r)["label"]; ok {
+		update["label"] = label
+	}
+
+	writeJSON(w, http.StatusOK, s.update(instanceDisksCollection(id), diskID, update))
+}
+
+func (s *Server) resizeInstanceDisk(w http.ResponseWriter, r *http.Request, params []string) {
+	inst, id, ok := s.find(w, collectionInstances, params[0])
+	if !ok {
+		return
+	}
+
+	_, diskID, ok := s.find(w, instanceDisksCollection(id), params[1])
+	if !ok {
+		return
+	}
+
+	size := asInt(readBody(r)["size"])
+	if s.instanceDiskUsage(id, diskID)+size > asInt(inst["specs"].(object)["disk"]) {
+		writeError(w, http.StatusBadRequest, "size", "Insufficient space to resize this disk")
+		return
+	}
+
+	disk := s.update(instanceDisksCollection(id), diskID, object{"size": size})
+	s.addEvent("disk_resize", linodeEntity(inst), diskEntity(disk))
+
+	writeEmpty(w)
+}
+
+func (s *Server) deleteInstanceDisk(w http.ResponseWriter, _ *http.Request, params []string) {
+	inst, id, ok := s.find(w, collectionInstances, params[0])
+	if !ok {
+		return
+	}
+
+	disk, diskID, ok := s.find(w, instanceDisksCollection(id), params[1])
+	if !ok {
+		return
+	}
+
+	s.remove(instanceDisksCollection(id), diskID)
+	s.addEvent("disk_delete", linodeEntity(inst), diskEntity(disk))
+
+	writeEmpty(w)
+}
+
+// newConfig builds an instance config from a create request body,
+// applying the API's defaults for omitted fields.
+func (s *Server) newConfig(body object) object {
+	config := object{
+		"label":        "",
+		"comments":     "",
+		"kernel":       "linode/grub2",
+		"memory_limit": 0,
+		"root_device":  "/dev/sda",
+		"run_level":    "default",
+		"virt_mode":    "paravirt",
+		"devices":      object{},
+		"helpers": object{
+			"updatedb_disabled":  true,
+			"distro":             true,
+			"modules_dep":        true,
+			"network":            true,
+			"devtmpfs_automount": true,
+		},
+	}
+
+	for k, v := range body {
+		if v != nil {
+			config[k] = v
+		}
+	}
+
+	config["interfaces"] = s.newConfigInterfaces(asList(body["interfaces"]))
+
+	return config
+}
+
+func (s *Server) newConfigInterfaces(interfaces []any) []any {
+	result := make([]any, len(interfaces))
+
+	for i, raw := range interfaces {
+		iface := object{
+			"label":        "",
+			"ipam_address": "",
+			"purpose":      "public",
+			"primary":      false,
+			"active":       true,
+			"vpc_id":       nil,
+			"subnet_id":    nil,
+			"ipv4":         nil,
+			"ip_ranges":    []any{},
+		}
+
+		if options, ok := raw.(object); ok {
+			for k, v := range options {
+				iface[k] = v
+			}
+		}
+
+		if iface["purpose"] == "vpc" {
+			if vpcID, ok := s.subnetVPC(asInt(iface["subnet_id"])); ok {
+				iface["vpc_id"] = vpcID
+			}
+		}
+
+		s.nextID++
+		iface["id"] = s.nextID
+
+		result[i] = iface
+	}
+
+	return result
+}
+
+func (s *Server) listInstanceConfigs(w http.ResponseWriter, r *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionInstances, params[0])
+	if !ok {
+		return
+	}
+
+	writeList(w, r, s.list(instanceConfigsCollection(id)))
+}
+
+func (s *Server) getInstanceConfig(w http.ResponseWriter, _ *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionInstances, params[0])
+	if !ok {
+		return
+	}
+
+	config, _, ok := s.find(w, instanceConfigsCollection(id), params[1])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, config)
+}
+
+func (s *Server) createInstanceConfig(w http.ResponseWriter, r *http.Request, params []string) {
+	inst, id, ok := s.find(w, collectionInstances, params[0])
+	if !ok {
+		return
+	}
+
+	body := readBody(r)
+	if asString(body["label"]) == "" {
+		writeError(w, http.StatusBadRequest, "label", "label is required")
+		return
+	}
+
+	config := s.insert(instanceConfigsCollection(id), s.newConfig(body))
+	s.addEvent("linode_config_create", linodeEntity(inst), configEntity(config))
+
+	writeJSON(w, http.StatusOK, config)
+}
+
+func (s *Server) updateInstanceConfig(w http.ResponseWriter, r *http.Request, params []string) {
+	inst, id, ok := s.find(w, collectionInstances, params[0])
+	if !ok {
+		return
+	}
+
+	_, configID, ok := s.find(w, instanceConfigsCollection(id), params[1])
+	if !ok {
+		return
+	}
+
+	body := readBody(r)
+	if interfaces, ok := body["interfaces"]; ok {
+		body["interfaces"] = s.newConfigInterfaces(asList(interfaces))
+	}
+
+	config := s.update(instanceConfigsCollection(id), configID, body)
+	s.addEvent("linode_config_update", linodeEntity(inst), configEntity(config))
+
+	writeJSON(w, http.StatusOK, config)
+}
+
+func (s *Server) deleteInstanceConfig(w http.ResponseWriter, _ *http.Request, params []string) {
+	inst, id, ok := s.find(w, collectionInstances, params[0])
+	if !ok {
+		return
+	}
+
+	config, configID, ok := s.find(w, instanceConfigsCollection(id), params[1])
+	if !ok {
+		return
+	}
+
+	s.remove(instanceConfigsCollection(id), configID)
+	s.addEvent("linode_config_delete", linodeEntity(inst), configEntity(config))
+
+	writeEmpty(w)
+}
@@ -0,0 +1,45 @@
+package fakeapi
+
+import (
+	"context"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+// Token is the access token used by configurations targeting the fake server.
+const Token = "fakeapi-token"
+
+// Config returns a provider configuration targeting the fake server.
+// Polling intervals are shortened since all fake operations finish immediately.
+func (s *Server) Config() *helper.Config {
+	return &helper.Config{
+		AccessToken:                  Token,
+		APIURL:                       s.URL,
+		APIVersion:                   "v4",
+		TerraformVersion:             "fakeapi",
+		EventPollMilliseconds:        10,
+		LKEEventPollMilliseconds:     10,
+		LKENodeReadyPollMilliseconds: 10,
+		MinRetryDelayMilliseconds:    10,
+		MaxRetryDelayMilliseconds:    50,
+	}
+}
+
+// ProviderMeta returns SDKv2 provider metadata with a client targeting the fake server.
+func (s *Server) ProviderMeta(ctx context.Context) (*helper.ProviderMeta, error) {
+	config := s.Config()
+
+	client, err := config.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	meta := &helper.ProviderMeta{
+		Client: *client,
+		Config: config,
+	}
+	meta.EventWatcher = helper.NewEventWatcher(&meta.Client)
+	meta.LinodeMutex = helper.LinodeMutex()
+
+	return meta, nil
+}
@@ -0,0 +1,184 @@
+// Package fakeapi implements a stateful, in-memory fake of the subset of
+// the Linode APIv4 used by this provider.
+//
+// The fake is intended to be pointed at through the provider's `url`
+// attribute (or helper.Config.APIURL) so that clients, event pollers and
+// resource CRUD functions can be exercised without network access.
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const timeFormat = "2006-01-02T15:04:05"
+
+// Request is a single request that was received by the fake server.
+type Request struct {
+	Method string
+	Path   string
+	Filter string
+}
+
+// Server is an in-memory fake of the Linode APIv4.
+type Server struct {
+	*httptest.Server
+
+	mu sync.Mutex
+
+	nextID      int
+	nextAddress int
+	collections map[string]map[int]object
+	routes      []route
+	requests    []Request
+}
+
+type handlerFunc func(w http.ResponseWriter, r *http.Request, params []string)
+
+type route struct {
+	method  string
+	pattern *regexp.Regexp
+	handler handlerFunc
+}
+
+// New starts and returns a new fake API server.
+// The caller is responsible for closing the server.
+func New() *Server {
+	s := &Server{
+		nextID:      1000,
+		collections: make(map[string]map[int]object),
+	}
+
+	s.registerAccountRoutes()
+	s.registerLinodeRoutes()
+	s.registerVolumeRoutes()
+	s.registerFirewallRoutes()
+	s.registerVPCRoutes()
+	s.registerDomainRoutes()
+	s.registerCatalogRoutes()
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Requests returns all requests received by the server so far.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]Request, len(s.requests))
+	copy(result, s.requests)
+
+	return result
+}
+
+// CountRequests returns the number of received requests matching
+// the given method and path.
+func (s *Server) CountRequests(method, path string) int {
+	count := 0
+
+	for _, req := range s.Requests() {
+		if req.Method == method && req.Path == path {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (s *Server) handle(method, pattern string, handler handlerFunc) {
+	s.routes = append(s.routes, route{
+		method:  method,
+		pattern: regexp.MustCompile("^" + pattern + "$"),
+		handler: handler,
+	})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Drop the API version (e.g. v4, v4beta) from the request path
+	path := strings.Trim(r.URL.Path, "/")
+	if _, rest, ok := strings.Cut(path, "/"); ok {
+		path = rest
+	}
+
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   path,
+		Filter: r.Header.Get("X-Filter"),
+	})
+
+	if r.Header.Get("Authorization") == "" {
+		writeError(w, http.StatusUnauthorized, "", "Invalid Token")
+		return
+	}
+
+	for _, rt := range s.routes {
+		if rt.method != r.Method {
+			continue
+		}
+
+		match := rt.pattern.FindStringSubmatch(path)
+		if match == nil {
+			continue
+		}
+
+		rt.handler(w, r, match[1:])
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "", "Not found")
+}
+
+func now() string {
+	return time.Now().UTC().Format(timeFormat)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		panic(fmt.Sprintf("failed to encode fake API response: %s", err))
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, field, reason string) {
+	apiErr := map[string]any{"reason": reason}
+	if field != "" {
+		apiErr["field"] = field
+	}
+
+	writeJSON(w, status, map[string]any{
+		"errors": []any{apiErr},
+	})
+}
+
+func writeEmpty(w http.ResponseWriter) {
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+func readBody(r *http.Request) object {
+	result := object{}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil || len(body) == 0 {
+		return result
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return object{}
+	}
+
+	return result
+}
@@ -0,0 +1,203 @@
+//go:build unit
+
+package fakeapi_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/fakeapi"
+)
+
+func newTestClient(t *testing.T) (*fakeapi.Server, *linodego.Client) {
+	t.Helper()
+
+	server := fakeapi.New()
+	t.Cleanup(server.Close)
+
+	client, err := server.Config().Client(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	return server, client
+}
+
+func TestInstanceLifecycle(t *testing.T) {
+	ctx := context.Background()
+	server, client := newTestClient(t)
+
+	p, err := client.NewEventPollerWithoutEntity(linodego.EntityLinode, linodego.ActionLinodeCreate)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inst, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{
+		Region:   "us-east",
+		Type:     "g6-nanode-1",
+		Image:    "linode/alpine3.19",
+		RootPass: "v3ry-s3cure-p4ss",
+	})
+	if err != nil {
+		t.Fatalf("failed to create instance: %s", err)
+	}
+
+	p.EntityID = inst.ID
+
+	if _, err := p.WaitForFinished(ctx, 5); err != nil {
+		t.Fatalf("failed to wait for instance create: %s", err)
+	}
+
+	if inst.Status != linodego.InstanceRunning {
+		t.Errorf("expected instance to be running, got %s", inst.Status)
+	}
+
+	disks, err := client.ListInstanceDisks(ctx, inst.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(disks) != 2 {
+		t.Fatalf("expected 2 implicit disks, got %d", len(disks))
+	}
+
+	if total := disks[0].Size + disks[1].Size; total != inst.Specs.Disk {
+		t.Errorf("expected implicit disks to fill %d MB, got %d MB", inst.Specs.Disk, total)
+	}
+
+	configs, err := client.ListInstanceConfigs(ctx, inst.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(configs) != 1 || configs[0].Devices.SDA.DiskID != disks[0].ID {
+		t.Fatalf("expected a single config booting from the implicit disk, got %v", configs)
+	}
+
+	if err := client.ShutdownInstance(ctx, inst.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.WaitForInstanceStatus(ctx, inst.ID, linodego.InstanceOffline, 5); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.DeleteInstance(ctx, inst.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetInstance(ctx, inst.ID); !linodego.IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	if server.CountRequests("POST", "linode/instances") != 1 {
+		t.Errorf("expected a single create request")
+	}
+}
+
+func TestInstanceDiskSpaceValidation(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+
+	inst, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{
+		Region: "us-east",
+		Type:   "g6-nanode-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.CreateInstanceDisk(ctx, inst.ID, linodego.InstanceDiskCreateOptions{
+		Label: "too-big",
+		Size:  inst.Specs.Disk + 1,
+	}); err == nil {
+		t.Fatal("expected an error when exceeding the plan's disk allocation")
+	}
+
+	if err := client.BootInstance(ctx, inst.ID, 0); err == nil {
+		t.Fatal("expected an error when booting an instance without configs")
+	}
+}
+
+func TestListFilters(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+
+	for _, label := range []string{"vol-a", "vol-b", "vol-c"} {
+		if _, err := client.CreateVolume(ctx, linodego.VolumeCreateOptions{
+			Label:  label,
+			Region: "us-east",
+			Tags:   []string{"env:" + label},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	volumes, err := client.ListVolumes(ctx, &linodego.ListOptions{Filter: `{"label": "vol-b"}`})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(volumes) != 1 || volumes[0].Label != "vol-b" {
+		t.Fatalf("expected only vol-b, got %v", volumes)
+	}
+
+	volumes, err = client.ListVolumes(ctx, &linodego.ListOptions{Filter: `{"tags": "env:vol-c"}`})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(volumes) != 1 || volumes[0].Label != "vol-c" {
+		t.Fatalf("expected only vol-c, got %v", volumes)
+	}
+}
+
+func TestFirewallDevices(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+
+	inst, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{
+		Region: "us-east",
+		Type:   "g6-nanode-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	firewall, err := client.CreateFirewall(ctx, linodego.FirewallCreateOptions{
+		Label: "test-firewall",
+		Rules: linodego.FirewallRuleSet{
+			InboundPolicy:  "DROP",
+			OutboundPolicy: "ACCEPT",
+		},
+		Devices: linodego.DevicesCreationOptions{
+			Linodes: []int{inst.ID},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	devices, err := client.ListFirewallDevices(ctx, firewall.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(devices) != 1 || devices[0].Entity.ID != inst.ID {
+		t.Fatalf("expected instance %d to be attached, got %v", inst.ID, devices)
+	}
+
+	if err := client.DeleteInstance(ctx, inst.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	devices, err = client.ListFirewallDevices(ctx, firewall.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(devices) != 0 {
+		t.Fatalf("expected devices to be removed with the instance, got %v", devices)
+	}
+}
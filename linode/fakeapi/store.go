@@ -0,0 +1,318 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const defaultPageSize = 100
+
+// object is the JSON representation of a single API entity.
+type object = map[string]any
+
+// normalize round-trips the given value through JSON so all stored
+// values share the representation of decoded request bodies.
+func normalize(v any) object {
+	result := object{}
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf("failed to marshal fake API object: %s", err))
+	}
+
+	if err := json.Unmarshal(raw, &result); err != nil {
+		panic(fmt.Sprintf("failed to unmarshal fake API object: %s", err))
+	}
+
+	return result
+}
+
+// insert assigns an ID to the given object and stores it in the collection.
+func (s *Server) insert(collection string, obj object) object {
+	s.nextID++
+
+	obj["id"] = s.nextID
+	obj["created"] = now()
+	obj["updated"] = now()
+
+	return s.store(collection, s.nextID, obj)
+}
+
+// store stores the given object under the given ID in the collection.
+func (s *Server) store(collection string, id int, obj object) object {
+	if _, ok := s.collections[collection]; !ok {
+		s.collections[collection] = make(map[int]object)
+	}
+
+	result := normalize(obj)
+	s.collections[collection][id] = result
+
+	return result
+}
+
+// update merges the top-level fields of the given body into a stored object.
+func (s *Server) update(collection string, id int, body object) object {
+	obj := s.collections[collection][id]
+
+	for k, v := range body {
+		obj[k] = v
+	}
+
+	obj["updated"] = now()
+
+	return s.store(collection, id, obj)
+}
+
+func (s *Server) lookup(collection string, id int) (object, bool) {
+	obj, ok := s.collections[collection][id]
+	return obj, ok
+}
+
+// list returns all objects in the collection ordered by ID.
+func (s *Server) list(collection string) []object {
+	ids := make([]int, 0, len(s.collections[collection]))
+	for id := range s.collections[collection] {
+		ids = append(ids, id)
+	}
+
+	sort.Ints(ids)
+
+	result := make([]object, len(ids))
+	for i, id := range ids {
+		result[i] = s.collections[collection][id]
+	}
+
+	return result
+}
+
+// remove deletes an object along with all of its nested collections.
+func (s *Server) remove(collection string, id int) {
+	delete(s.collections[collection], id)
+
+	prefix := fmt.Sprintf("%s/%d/", collection, id)
+	for name := range s.collections {
+		if strings.HasPrefix(name, prefix) {
+			delete(s.collections, name)
+		}
+	}
+}
+
+// find resolves the object with the ID in the given path parameter,
+// writing a 404 response if it does not exist.
+func (s *Server) find(w http.ResponseWriter, collection, rawID string) (object, int, bool) {
+	id, err := strconv.Atoi(rawID)
+	if err != nil {
+		writeError(w, http.StatusNotFound, "", "Not found")
+		return nil, 0, false
+	}
+
+	obj, ok := s.lookup(collection, id)
+	if !ok {
+		writeError(w, http.StatusNotFound, "", "Not found")
+		return nil, 0, false
+	}
+
+	return obj, id, true
+}
+
+// writeList filters and paginates the given objects according to
+// the X-Filter header and page query parameters of the request.
+func writeList(w http.ResponseWriter, r *http.Request, objects []object) {
+	filter := object{}
+
+	if raw := r.Header.Get("X-Filter"); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &filter); err != nil {
+			writeError(w, http.StatusBadRequest, "X-Filter", "Cannot parse filter")
+			return
+		}
+	}
+
+	filtered := make([]object, 0, len(objects))
+	for _, obj := range objects {
+		if matchesFilter(obj, filter) {
+			filtered = append(filtered, obj)
+		}
+	}
+
+	if orderBy, ok := filter["+order_by"].(string); ok {
+		descending := filter["+order"] == "desc"
+
+		sort.SliceStable(filtered, func(i, j int) bool {
+			less := compareValues(lookupField(filtered[i], orderBy), lookupField(filtered[j], orderBy)) < 0
+			if descending {
+				return !less
+			}
+			return less
+		})
+	}
+
+	page := queryInt(r, "page", 1)
+	pageSize := queryInt(r, "page_size", defaultPageSize)
+
+	pages := (len(filtered) + pageSize - 1) / pageSize
+	if pages < 1 {
+		pages = 1
+	}
+
+	start := min((page-1)*pageSize, len(filtered))
+	end := min(start+pageSize, len(filtered))
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"data":    filtered[start:end],
+		"page":    page,
+		"pages":   pages,
+		"results": len(filtered),
+	})
+}
+
+func queryInt(r *http.Request, key string, defaultValue int) int {
+	result, err := strconv.Atoi(r.URL.Query().Get(key))
+	if err != nil || result < 1 {
+		return defaultValue
+	}
+
+	return result
+}
+
+// matchesFilter reports whether the given object matches an API filter.
+func matchesFilter(obj, filter object) bool {
+	for key, expected := range filter {
+		switch key {
+		case "+order_by", "+order":
+			continue
+		case "+and":
+			for _, sub := range asList(expected) {
+				if subFilter, ok := sub.(object); ok && !matchesFilter(obj, subFilter) {
+					return false
+				}
+			}
+		case "+or":
+			matched := false
+			for _, sub := range asList(expected) {
+				if subFilter, ok := sub.(object); ok && matchesFilter(obj, subFilter) {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return false
+			}
+		default:
+			if !matchesField(lookupField(obj, key), expected) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func matchesField(actual, expected any) bool {
+	operators, ok := expected.(object)
+	if !ok {
+		// Filtering on a list field (e.g. tags) matches any element
+		if values, ok := actual.([]any); ok {
+			for _, v := range values {
+				if compareValues(v, expected) == 0 {
+					return true
+				}
+			}
+			return false
+		}
+
+		return compareValues(actual, expected) == 0
+	}
+
+	for op, operand := range operators {
+		cmp := compareValues(actual, operand)
+
+		var result bool
+
+		switch op {
+		case "+neq":
+			result = cmp != 0
+		case "+gt":
+			result = cmp > 0
+		case "+gte":
+			result = cmp >= 0
+		case "+lt":
+			result = cmp < 0
+		case "+lte":
+			result = cmp <= 0
+		case "+contains":
+			result = strings.Contains(fmt.Sprint(actual), fmt.Sprint(operand))
+		default:
+			result = false
+		}
+
+		if !result {
+			return false
+		}
+	}
+
+	return true
+}
+
+// lookupField resolves a dotted field path (e.g. entity.id) in an object.
+func lookupField(obj object, path string) any {
+	var current any = obj
+
+	for _, segment := range strings.Split(path, ".") {
+		m, ok := current.(object)
+		if !ok {
+			return nil
+		}
+		current = m[segment]
+	}
+
+	return current
+}
+
+func compareValues(a, b any) int {
+	af, aNumeric := a.(float64)
+	bf, bNumeric := b.(float64)
+
+	if aNumeric && bNumeric {
+		switch {
+		case af < bf:
+			return -1
+		case af > bf:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+func asList(v any) []any {
+	if result, ok := v.([]any); ok {
+		return result
+	}
+
+	return nil
+}
+
+func asInt(v any) int {
+	switch value := v.(type) {
+	case float64:
+		return int(value)
+	case int:
+		return value
+	default:
+		return 0
+	}
+}
+
+func asString(v any) string {
+	if result, ok := v.(string); ok {
+		return result
+	}
+
+	return ""
+}
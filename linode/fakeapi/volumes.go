@@ -0,0 +1,226 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	collectionVolumes = "volumes"
+
+	defaultVolumeSize = 20
+)
+
+func (s *Server) registerVolumeRoutes() {
+	s.handle(http.MethodGet, "volumes", s.listVolumes)
+	s.handle(http.MethodPost, "volumes", s.createVolume)
+	s.handle(http.MethodGet, `volumes/(\d+)`, s.getVolume)
+	s.handle(http.MethodPut, `volumes/(\d+)`, s.updateVolume)
+	s.handle(http.MethodDelete, `volumes/(\d+)`, s.deleteVolume)
+	s.handle(http.MethodPost, `volumes/(\d+)/attach`, s.attachVolume)
+	s.handle(http.MethodPost, `volumes/(\d+)/detach`, s.detachVolume)
+	s.handle(http.MethodPost, `volumes/(\d+)/resize`, s.resizeVolume)
+	s.handle(http.MethodPost, `volumes/(\d+)/clone`, s.cloneVolume)
+}
+
+func volumeEntity(volume object) object {
+	id := asInt(volume["id"])
+	return entityRef("volume", id, asString(volume["label"]), entityURL("volumes/%d", id))
+}
+
+func (s *Server) listVolumes(w http.ResponseWriter, r *http.Request, _ []string) {
+	writeList(w, r, s.list(collectionVolumes))
+}
+
+func (s *Server) getVolume(w http.ResponseWriter, _ *http.Request, params []string) {
+	volume, _, ok := s.find(w, collectionVolumes, params[0])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, volume)
+}
+
+func (s *Server) createVolume(w http.ResponseWriter, r *http.Request, _ []string) {
+	body := readBody(r)
+
+	label := asString(body["label"])
+	if label == "" {
+		writeError(w, http.StatusBadRequest, "label", "label is required")
+		return
+	}
+
+	region := asString(body["region"])
+
+	var linodeID, linodeLabel any
+	if id := asInt(body["linode_id"]); id != 0 {
+		inst, ok := s.lookup(collectionInstances, id)
+		if !ok {
+			writeError(w, http.StatusBadRequest, "linode_id", "Linode not found")
+			return
+		}
+
+		linodeID, linodeLabel, region = id, inst["label"], asString(inst["region"])
+	}
+
+	if _, ok := lookupRegion(region); !ok {
+		writeError(w, http.StatusBadRequest, "region", "region is not valid")
+		return
+	}
+
+	size := asInt(body["size"])
+	if size == 0 {
+		size = defaultVolumeSize
+	}
+
+	tags := asList(body["tags"])
+	if tags == nil {
+		tags = []any{}
+	}
+
+	encryption := asString(body["encryption"])
+	if encryption == "" {
+		encryption = "disabled"
+	}
+
+	volume := s.insert(collectionVolumes, object{
+		"label":           label,
+		"status":          "active",
+		"region":          region,
+		"size":            size,
+		"linode_id":       linodeID,
+		"linode_label":    linodeLabel,
+		"filesystem_path": fmt.Sprintf("/dev/disk/by-id/scsi-0Linode_Volume_%s", label),
+		"tags":            tags,
+		"hardware_type":   "nvme",
+		"encryption":      encryption,
+	})
+	s.addEvent("volume_create", volumeEntity(volume), nil)
+
+	writeJSON(w, http.StatusOK, volume)
+}
+
+func (s *Server) updateVolume(w http.ResponseWriter, r *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionVolumes, params[0])
+	if !ok {
+		return
+	}
+
+	body := readBody(r)
+	update := object{}
+
+	for _, field := range []string{"label", "tags"} {
+		if v, ok := body[field]; ok {
+			update[field] = v
+		}
+	}
+
+	writeJSON(w, http.StatusOK, s.update(collectionVolumes, id, update))
+}
+
+func (s *Server) deleteVolume(w http.ResponseWriter, _ *http.Request, params []string) {
+	volume, id, ok := s.find(w, collectionVolumes, params[0])
+	if !ok {
+		return
+	}
+
+	if volume["linode_id"] != nil {
+		writeError(w, http.StatusBadRequest, "", "Volume must be detached before it can be deleted")
+		return
+	}
+
+	s.remove(collectionVolumes, id)
+	s.addEvent("volume_delete", volumeEntity(volume), nil)
+
+	writeEmpty(w)
+}
+
+func (s *Server) attachVolume(w http.ResponseWriter, r *http.Request, params []string) {
+	volume, id, ok := s.find(w, collectionVolumes, params[0])
+	if !ok {
+		return
+	}
+
+	body := readBody(r)
+
+	inst, ok := s.lookup(collectionInstances, asInt(body["linode_id"]))
+	if !ok {
+		writeError(w, http.StatusBadRequest, "linode_id", "Linode not found")
+		return
+	}
+
+	if volume["linode_id"] != nil && asInt(volume["linode_id"]) != asInt(inst["id"]) {
+		writeError(w, http.StatusBadRequest, "", "Volume is already attached to a Linode")
+		return
+	}
+
+	if inst["region"] != volume["region"] {
+		writeError(w, http.StatusBadRequest, "linode_id", "Volume and Linode must be in the same region")
+		return
+	}
+
+	volume = s.update(collectionVolumes, id, object{
+		"linode_id":    inst["id"],
+		"linode_label": inst["label"],
+	})
+	s.addEvent("volume_attach", volumeEntity(volume), nil)
+
+	writeJSON(w, http.StatusOK, volume)
+}
+
+func (s *Server) detachVolume(w http.ResponseWriter, _ *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionVolumes, params[0])
+	if !ok {
+		return
+	}
+
+	volume := s.update(collectionVolumes, id, object{
+		"linode_id":    nil,
+		"linode_label": nil,
+	})
+	s.addEvent("volume_detach", volumeEntity(volume), nil)
+
+	writeEmpty(w)
+}
+
+func (s *Server) resizeVolume(w http.ResponseWriter, r *http.Request, params []string) {
+	volume, id, ok := s.find(w, collectionVolumes, params[0])
+	if !ok {
+		return
+	}
+
+	size := asInt(readBody(r)["size"])
+	if size < asInt(volume["size"]) {
+		writeError(w, http.StatusBadRequest, "size", "Volumes can only be resized up")
+		return
+	}
+
+	volume = s.update(collectionVolumes, id, object{"size": size})
+	s.addEvent("volume_resize", volumeEntity(volume), nil)
+
+	writeEmpty(w)
+}
+
+func (s *Server) cloneVolume(w http.ResponseWriter, r *http.Request, params []string) {
+	source, _, ok := s.find(w, collectionVolumes, params[0])
+	if !ok {
+		return
+	}
+
+	label := asString(readBody(r)["label"])
+
+	clone := object{}
+	for k, v := range source {
+		clone[k] = v
+	}
+
+	clone["label"] = label
+	clone["linode_id"] = nil
+	clone["linode_label"] = nil
+	clone["filesystem_path"] = fmt.Sprintf("/dev/disk/by-id/scsi-0Linode_Volume_%s", label)
+
+	volume := s.insert(collectionVolumes, clone)
+	s.addEvent("volume_clone", volumeEntity(source), nil)
+
+	writeJSON(w, http.StatusOK, volume)
+}
@@ -0,0 +1,270 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const collectionVPCs = "vpcs"
+
+func vpcSubnetsCollection(vpcID int) string {
+	return fmt.Sprintf("%s/%d/subnets", collectionVPCs, vpcID)
+}
+
+func (s *Server) registerVPCRoutes() {
+	s.handle(http.MethodGet, "vpcs", s.listVPCs)
+	s.handle(http.MethodPost, "vpcs", s.createVPC)
+	s.handle(http.MethodGet, `vpcs/(\d+)`, s.getVPC)
+	s.handle(http.MethodPut, `vpcs/(\d+)`, s.updateVPC)
+	s.handle(http.MethodDelete, `vpcs/(\d+)`, s.deleteVPC)
+
+	s.handle(http.MethodGet, `vpcs/(\d+)/subnets`, s.listVPCSubnets)
+	s.handle(http.MethodPost, `vpcs/(\d+)/subnets`, s.createVPCSubnet)
+	s.handle(http.MethodGet, `vpcs/(\d+)/subnets/(\d+)`, s.getVPCSubnet)
+	s.handle(http.MethodPut, `vpcs/(\d+)/subnets/(\d+)`, s.updateVPCSubnet)
+	s.handle(http.MethodDelete, `vpcs/(\d+)/subnets/(\d+)`, s.deleteVPCSubnet)
+}
+
+// subnetVPC returns the ID of the VPC containing the given subnet.
+func (s *Server) subnetVPC(subnetID int) (int, bool) {
+	for _, vpc := range s.list(collectionVPCs) {
+		vpcID := asInt(vpc["id"])
+		if _, ok := s.lookup(vpcSubnetsCollection(vpcID), subnetID); ok {
+			return vpcID, true
+		}
+	}
+
+	return 0, false
+}
+
+// subnetLinodes returns the Linodes with a config interface in the given subnet.
+func (s *Server) subnetLinodes(subnetID int) []any {
+	result := []any{}
+
+	for _, inst := range s.list(collectionInstances) {
+		linodeID := asInt(inst["id"])
+		interfaces := []any{}
+
+		for _, config := range s.list(instanceConfigsCollection(linodeID)) {
+			for _, raw := range asList(config["interfaces"]) {
+				iface, ok := raw.(object)
+				if !ok || iface["purpose"] != "vpc" || asInt(iface["subnet_id"]) != subnetID {
+					continue
+				}
+
+				interfaces = append(interfaces, object{
+					"id":     iface["id"],
+					"active": inst["status"] == "running",
+				})
+			}
+		}
+
+		if len(interfaces) > 0 {
+			result = append(result, object{
+				"id":         linodeID,
+				"interfaces": interfaces,
+			})
+		}
+	}
+
+	return result
+}
+
+// subnetObject populates the computed fields of a stored subnet.
+func (s *Server) subnetObject(subnet object) object {
+	result := normalize(subnet)
+	result["linodes"] = s.subnetLinodes(asInt(subnet["id"]))
+
+	return result
+}
+
+// vpcObject populates the computed fields of a stored VPC.
+func (s *Server) vpcObject(vpc object) object {
+	result := normalize(vpc)
+	subnets := []any{}
+
+	for _, subnet := range s.list(vpcSubnetsCollection(asInt(vpc["id"]))) {
+		subnets = append(subnets, s.subnetObject(subnet))
+	}
+
+	result["subnets"] = subnets
+
+	return result
+}
+
+func (s *Server) listVPCs(w http.ResponseWriter, r *http.Request, _ []string) {
+	result := []object{}
+	for _, vpc := range s.list(collectionVPCs) {
+		result = append(result, s.vpcObject(vpc))
+	}
+
+	writeList(w, r, result)
+}
+
+func (s *Server) getVPC(w http.ResponseWriter, _ *http.Request, params []string) {
+	vpc, _, ok := s.find(w, collectionVPCs, params[0])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.vpcObject(vpc))
+}
+
+func (s *Server) createVPC(w http.ResponseWriter, r *http.Request, _ []string) {
+	body := readBody(r)
+
+	label := asString(body["label"])
+	if label == "" {
+		writeError(w, http.StatusBadRequest, "label", "label is required")
+		return
+	}
+
+	region := asString(body["region"])
+	if _, ok := lookupRegion(region); !ok {
+		writeError(w, http.StatusBadRequest, "region", "region is not valid")
+		return
+	}
+
+	vpc := s.insert(collectionVPCs, object{
+		"label":       label,
+		"description": asString(body["description"]),
+		"region":      region,
+	})
+	vpcID := asInt(vpc["id"])
+
+	for _, raw := range asList(body["subnets"]) {
+		if subnet, ok := raw.(object); ok {
+			s.insert(vpcSubnetsCollection(vpcID), object{
+				"label": asString(subnet["label"]),
+				"ipv4":  asString(subnet["ipv4"]),
+			})
+		}
+	}
+
+	writeJSON(w, http.StatusOK, s.vpcObject(vpc))
+}
+
+func (s *Server) updateVPC(w http.ResponseWriter, r *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionVPCs, params[0])
+	if !ok {
+		return
+	}
+
+	body := readBody(r)
+	update := object{}
+
+	for _, field := range []string{"label", "description"} {
+		if v, ok := body[field]; ok {
+			update[field] = v
+		}
+	}
+
+	writeJSON(w, http.StatusOK, s.vpcObject(s.update(collectionVPCs, id, update)))
+}
+
+func (s *Server) deleteVPC(w http.ResponseWriter, _ *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionVPCs, params[0])
+	if !ok {
+		return
+	}
+
+	for _, subnet := range s.list(vpcSubnetsCollection(id)) {
+		if len(s.subnetLinodes(asInt(subnet["id"]))) > 0 {
+			writeError(w, http.StatusBadRequest, "", "VPC has subnets with assigned Linodes")
+			return
+		}
+	}
+
+	s.remove(collectionVPCs, id)
+
+	writeEmpty(w)
+}
+
+func (s *Server) listVPCSubnets(w http.ResponseWriter, r *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionVPCs, params[0])
+	if !ok {
+		return
+	}
+
+	result := []object{}
+	for _, subnet := range s.list(vpcSubnetsCollection(id)) {
+		result = append(result, s.subnetObject(subnet))
+	}
+
+	writeList(w, r, result)
+}
+
+func (s *Server) getVPCSubnet(w http.ResponseWriter, _ *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionVPCs, params[0])
+	if !ok {
+		return
+	}
+
+	subnet, _, ok := s.find(w, vpcSubnetsCollection(id), params[1])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.subnetObject(subnet))
+}
+
+func (s *Server) createVPCSubnet(w http.ResponseWriter, r *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionVPCs, params[0])
+	if !ok {
+		return
+	}
+
+	body := readBody(r)
+
+	if asString(body["ipv4"]) == "" {
+		writeError(w, http.StatusBadRequest, "ipv4", "ipv4 is required")
+		return
+	}
+
+	subnet := s.insert(vpcSubnetsCollection(id), object{
+		"label": asString(body["label"]),
+		"ipv4":  asString(body["ipv4"]),
+	})
+
+	writeJSON(w, http.StatusOK, s.subnetObject(subnet))
+}
+
+func (s *Server) updateVPCSubnet(w http.ResponseWriter, r *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionVPCs, params[0])
+	if !ok {
+		return
+	}
+
+	_, subnetID, ok := s.find(w, vpcSubnetsCollection(id), params[1])
+	if !ok {
+		return
+	}
+
+	update := object{}
+	if label, ok := readBody(r)["label"]; ok {
+		update["label"] = label
+	}
+
+	writeJSON(w, http.StatusOK, s.subnetObject(s.update(vpcSubnetsCollection(id), subnetID, update)))
+}
+
+func (s *Server) deleteVPCSubnet(w http.ResponseWriter, _ *http.Request, params []string) {
+	_, id, ok := s.find(w, collectionVPCs, params[0])
+	if !ok {
+		return
+	}
+
+	_, subnetID, ok := s.find(w, vpcSubnetsCollection(id), params[1])
+	if !ok {
+		return
+	}
+
+	if len(s.subnetLinodes(subnetID)) > 0 {
+		writeError(w, http.StatusBadRequest, "", "Subnet has assigned Linodes")
+		return
+	}
+
+	s.remove(vpcSubnetsCollection(id), subnetID)
+
+	writeEmpty(w)
+}
@@ -36,6 +36,7 @@ type FirewallResourceModel struct {
 	ID             types.String      `tfsdk:"id"`
 	Label          types.String      `tfsdk:"label"`
 	Tags           types.Set         `tfsdk:"tags"`
+	TagsAll        types.Set         `tfsdk:"tags_all"`
 	Disabled       types.Bool        `tfsdk:"disabled"`
 	Inbound        []RuleModel       `tfsdk:"inbound"`
 	InboundPolicy  types.String      `tfsdk:"inbound_policy"`
@@ -121,16 +122,20 @@ func (data *FirewallResourceModel) ExpandFirewallRuleSet(
 }
 
 func (data *FirewallResourceModel) getCreateOptions(
-	ctx context.Context, diags *diag.Diagnostics,
+	ctx context.Context, providerTags helper.ProviderTags, diags *diag.Diagnostics,
 ) (createOpts linodego.FirewallCreateOptions) {
 	createOpts.Label = data.Label.ValueString()
 
-	newDiags := data.Tags.ElementsAs(ctx, &createOpts.Tags, false)
+	var tags []string
+
+	newDiags := data.Tags.ElementsAs(ctx, &tags, false)
 	diags.Append(newDiags...)
 	if diags.HasError() {
 		return
 	}
 
+	createOpts.Tags = providerTags.MergeTags(tags)
+
 	createOpts.Devices.Linodes = helper.ExpandFwInt64Set(data.Linodes, diags)
 	if diags.HasError() {
 		return
@@ -153,18 +158,27 @@ func (data *FirewallResourceModel) getCreateOptions(
 }
 
 func (plan *FirewallResourceModel) getUpdateOptions(
-	ctx context.Context, state FirewallResourceModel, diags *diag.Diagnostics,
+	ctx context.Context,
+	state FirewallResourceModel,
+	providerTags helper.ProviderTags,
+	remoteTags []string,
+	diags *diag.Diagnostics,
 ) (updateOpts linodego.FirewallUpdateOptions, shouldUpdate bool) {
 	if !plan.Label.Equal(state.Label) {
 		updateOpts.Label = plan.Label.ValueString()
 		shouldUpdate = true
 	}
-	if !plan.Tags.Equal(state.Tags) {
-		newDiags := plan.Tags.ElementsAs(ctx, &updateOpts.Tags, false)
+	if plan.TagsHaveChanges(state) {
+		var tags []string
+
+		newDiags := plan.Tags.ElementsAs(ctx, &tags, false)
 		diags.Append(newDiags...)
 		if diags.HasError() {
 			return
 		}
+
+		tags = providerTags.UpdateTags(tags, remoteTags)
+		updateOpts.Tags = &tags
 		shouldUpdate = true
 	}
 	if !plan.Disabled.Equal(state.Disabled) {
@@ -224,17 +238,28 @@ func (data *FirewallResourceModel) flattenRules(
 }
 
 func (data *FirewallResourceModel) flattenFirewallForResource(
+	ctx context.Context,
 	firewall *linodego.Firewall,
+	providerTags helper.ProviderTags,
 	preserveKnown bool,
 	diags *diag.Diagnostics,
 ) {
 	data.ID = helper.KeepOrUpdateString(data.ID, strconv.Itoa(firewall.ID), preserveKnown)
 	data.Label = helper.KeepOrUpdateString(data.Label, firewall.Label, preserveKnown)
-	data.Tags = helper.KeepOrUpdateStringSet(data.Tags, firewall.Tags, preserveKnown, diags)
+
+	tags, tagsAll, newDiags := providerTags.FrameworkFlattenTags(ctx, firewall.Tags, data.Tags)
+	diags.Append(newDiags...)
+	if diags.HasError() {
+		return
+	}
+
+	data.Tags = helper.KeepOrUpdateStringSet(data.Tags, tags, preserveKnown, diags)
 	if diags.HasError() {
 		return
 	}
 
+	data.TagsAll = helper.KeepOrUpdateValue(data.TagsAll, tagsAll, preserveKnown)
+
 	data.Disabled = helper.KeepOrUpdateBool(data.Disabled, isDisabled(*firewall), preserveKnown)
 
 	data.InboundPolicy = helper.KeepOrUpdateString(data.InboundPolicy, firewall.Rules.InboundPolicy, preserveKnown)
@@ -314,6 +339,7 @@ func (data *FirewallResourceModel) CopyFrom(
 	data.ID = helper.KeepOrUpdateValue(data.ID, other.ID, preserveKnown)
 	data.Label = helper.KeepOrUpdateValue(data.Label, other.Label, preserveKnown)
 	data.Tags = helper.KeepOrUpdateValue(data.Tags, other.Tags, preserveKnown)
+	data.TagsAll = helper.KeepOrUpdateValue(data.TagsAll, other.TagsAll, preserveKnown)
 	data.Disabled = helper.KeepOrUpdateValue(data.Disabled, other.Disabled, preserveKnown)
 	data.InboundPolicy = helper.KeepOrUpdateValue(data.InboundPolicy, other.InboundPolicy, preserveKnown)
 	data.OutboundPolicy = helper.KeepOrUpdateValue(data.OutboundPolicy, other.OutboundPolicy, preserveKnown)
@@ -330,6 +356,10 @@ func (data *FirewallResourceModel) CopyFrom(
 	}
 }
 
+func (state *FirewallResourceModel) TagsHaveChanges(plan FirewallResourceModel) bool {
+	return !state.Tags.Equal(plan.Tags) || !state.TagsAll.Equal(plan.TagsAll)
+}
+
 func (state *FirewallResourceModel) RulesAndPoliciesHaveChanges(
 	ctx context.Context, plan FirewallResourceModel, diags *diag.Diagnostics,
 ) bool {
@@ -18,9 +18,10 @@ func NewResource() resource.Resource {
 	return &Resource{
 		BaseResource: helper.NewBaseResource(
 			helper.BaseResourceConfig{
-				Name:   "linode_firewall",
-				IDType: types.StringType,
-				Schema: &frameworkResourceSchema,
+				Name:        "linode_firewall",
+				IDType:      types.StringType,
+				Schema:      &frameworkResourceSchema,
+				ImportLabel: &importLabelConfig,
 			},
 		),
 	}
@@ -30,6 +31,22 @@ type Resource struct {
 	helper.BaseResource
 }
 
+var importLabelConfig = helper.ImportLabelConfig{
+	Lookup: func(ctx context.Context, client *linodego.Client, filter string) ([]string, error) {
+		firewalls, err := client.ListFirewalls(ctx, linodego.NewListOptions(0, filter))
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]string, 0, len(firewalls))
+		for _, firewall := range firewalls {
+			result = append(result, strconv.Itoa(firewall.ID))
+		}
+
+		return result, nil
+	},
+}
+
 func (r *Resource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -45,7 +62,9 @@ func (r *Resource) Create(
 		return
 	}
 
-	createOpts := plan.getCreateOptions(ctx, &resp.Diagnostics)
+	providerTags := r.Meta.Config.GetProviderTags(ctx)
+
+	createOpts := plan.getCreateOptions(ctx, providerTags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -56,7 +75,11 @@ func (r *Resource) Create(
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to Create Firewall", err.Error())
+		helper.FrameworkAddAPIError(
+			ctx, &resp.Diagnostics, req.Plan,
+			"Failed to Create Firewall",
+			err,
+		)
 		return
 	}
 
@@ -67,7 +90,7 @@ func (r *Resource) Create(
 		}
 	}
 
-	plan.flattenFirewallForResource(firewall, true, &resp.Diagnostics)
+	plan.flattenFirewallForResource(ctx, firewall, providerTags, true, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -82,6 +105,12 @@ func (r *Resource) Create(
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+func (r *Resource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	helper.FrameworkModifyPlanTagsAll(ctx, r.Meta, req, resp)
+}
+
 func (r *Resource) Read(
 	ctx context.Context,
 	req resource.ReadRequest,
@@ -119,7 +148,9 @@ func (r *Resource) Read(
 		return
 	}
 
-	state.flattenFirewallForResource(firewall, false, &resp.Diagnostics)
+	state.flattenFirewallForResource(
+		ctx, firewall, r.Meta.Config.GetProviderTags(ctx), false, &resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -155,7 +186,22 @@ func (r *Resource) Update(
 		return
 	}
 
-	updateOpts, shouldUpdate := plan.getUpdateOptions(ctx, state, &resp.Diagnostics)
+	providerTags := r.Meta.Config.GetProviderTags(ctx)
+
+	// Ignored tags must be preserved when updating the tags
+	var remoteTags []string
+	if providerTags.HasIgnoreTags() && state.TagsHaveChanges(plan) {
+		firewall, err := client.GetFirewall(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to Get Firewall %d", id), err.Error())
+			return
+		}
+		remoteTags = firewall.Tags
+	}
+
+	updateOpts, shouldUpdate := plan.getUpdateOptions(
+		ctx, state, providerTags, remoteTags, &resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -163,11 +209,15 @@ func (r *Resource) Update(
 	if shouldUpdate {
 		firewall, err := client.UpdateFirewall(ctx, id, updateOpts)
 		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Failed to Update Firewall %d", id), err.Error())
+			helper.FrameworkAddAPIError(
+				ctx, &resp.Diagnostics, req.Plan,
+				fmt.Sprintf("Failed to Update Firewall %d", id),
+				err,
+			)
 			return
 		}
 
-		plan.flattenFirewallForResource(firewall, true, &resp.Diagnostics)
+		plan.flattenFirewallForResource(ctx, firewall, providerTags, true, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -185,8 +235,10 @@ func (r *Resource) Update(
 
 		firewallRuleSet, err := client.UpdateFirewallRules(ctx, id, ruleSet)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				fmt.Sprintf("Failed to Update Rules for Firewall %d", id), err.Error(),
+			helper.FrameworkAddAPIError(
+				ctx, &resp.Diagnostics, req.Plan,
+				fmt.Sprintf("Failed to Update Rules for Firewall %d", id),
+				err,
 			)
 			return
 		}
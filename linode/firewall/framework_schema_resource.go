@@ -118,6 +118,11 @@ var frameworkResourceSchema = schema.Schema{
 			},
 			Default: helper.EmptySetDefault(types.StringType),
 		},
+		"tags_all": schema.SetAttribute{
+			Description: "All tags applied to this object, including those inherited from the provider's default_tags.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
 		"disabled": schema.BoolAttribute{
 			Description: "If true, the Firewall is inactive.",
 			Optional:    true,
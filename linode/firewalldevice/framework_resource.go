@@ -60,15 +60,25 @@ func (r *Resource) Create(
 		Type: linodego.FirewallDeviceType(plan.EntityType.ValueString()),
 	}
 
+	if createOpts.Type == linodego.FirewallDeviceLinode {
+		unlock, err := r.Meta.LockLinode(ctx, entityID)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to Lock Linode %d", entityID), err.Error())
+			return
+		}
+		defer unlock()
+	}
+
 	tflog.Debug(ctx, "client.CreateFirewallDevice(...)", map[string]any{
 		"firewall_id": firewallID,
 		"options":     createOpts,
 	})
 	device, err := client.CreateFirewallDevice(ctx, firewallID, createOpts)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helper.FrameworkAddAPIError(
+			ctx, &resp.Diagnostics, req.Plan,
 			"Error creating a Linode Firewall Device",
-			err.Error(),
+			err,
 		)
 		return
 	}
@@ -201,11 +211,24 @@ func (r *Resource) Delete(
 		state.FirewallID.ValueInt64(),
 		&resp.Diagnostics,
 	)
+	entityID := helper.FrameworkSafeInt64ToInt(
+		state.EntityID.ValueInt64(),
+		&resp.Diagnostics,
+	)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if linodego.FirewallDeviceType(state.EntityType.ValueString()) == linodego.FirewallDeviceLinode {
+		unlock, err := r.Meta.LockLinode(ctx, entityID)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to Lock Linode %d", entityID), err.Error())
+			return
+		}
+		defer unlock()
+	}
+
 	tflog.Debug(ctx, "client.DeleteFirewallDevice(...)", map[string]any{
 		"firewall_id": firewallID,
 		"device_id":   id,
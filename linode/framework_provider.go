@@ -5,10 +5,20 @@ import (
 
 	"github.com/linode/terraform-provider-linode/v2/linode/vpcips"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/terraform-provider-linode/v2/linode/account"
 	"github.com/linode/terraform-provider-linode/v2/linode/accountavailabilities"
 	"github.com/linode/terraform-provider-linode/v2/linode/accountavailability"
@@ -33,10 +43,13 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 	"github.com/linode/terraform-provider-linode/v2/linode/image"
 	"github.com/linode/terraform-provider-linode/v2/linode/images"
+	"github.com/linode/terraform-provider-linode/v2/linode/instancebackuprestore"
+	"github.com/linode/terraform-provider-linode/v2/linode/instanceclone"
 	"github.com/linode/terraform-provider-linode/v2/linode/instancedisk"
 	"github.com/linode/terraform-provider-linode/v2/linode/instanceip"
 	"github.com/linode/terraform-provider-linode/v2/linode/instancenetworking"
 	"github.com/linode/terraform-provider-linode/v2/linode/instancesharedips"
+	"github.com/linode/terraform-provider-linode/v2/linode/instancesnapshot"
 	"github.com/linode/terraform-provider-linode/v2/linode/instancetype"
 	"github.com/linode/terraform-provider-linode/v2/linode/instancetypes"
 	"github.com/linode/terraform-provider-linode/v2/linode/ipv6range"
@@ -45,6 +58,7 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/kernels"
 	"github.com/linode/terraform-provider-linode/v2/linode/lke"
 	"github.com/linode/terraform-provider-linode/v2/linode/lkeclusters"
+	"github.com/linode/terraform-provider-linode/v2/linode/lkekubeconfig"
 	"github.com/linode/terraform-provider-linode/v2/linode/lkenodepool"
 	"github.com/linode/terraform-provider-linode/v2/linode/lkeversions"
 	"github.com/linode/terraform-provider-linode/v2/linode/nb"
@@ -56,6 +70,8 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/objbucket"
 	"github.com/linode/terraform-provider-linode/v2/linode/objcluster"
 	"github.com/linode/terraform-provider-linode/v2/linode/objkey"
+	"github.com/linode/terraform-provider-linode/v2/linode/objtempkey"
+	"github.com/linode/terraform-provider-linode/v2/linode/parsekubeconfig"
 	"github.com/linode/terraform-provider-linode/v2/linode/placementgroup"
 	"github.com/linode/terraform-provider-linode/v2/linode/placementgroupassignment"
 	"github.com/linode/terraform-provider-linode/v2/linode/placementgroups"
@@ -77,6 +93,7 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/vpcs"
 	"github.com/linode/terraform-provider-linode/v2/linode/vpcsubnet"
 	"github.com/linode/terraform-provider-linode/v2/linode/vpcsubnets"
+	"github.com/linode/terraform-provider-linode/v2/linode/zonefile"
 )
 
 type FrameworkProvider struct {
@@ -89,8 +106,10 @@ func CreateFrameworkProviderWithMeta(version string, meta *helper.ProviderMeta)
 	return &FrameworkProvider{
 		ProviderVersion: version,
 		Meta: &helper.FrameworkProviderMeta{
-			Client: &meta.Client,
-			Config: helper.GetFrameworkProviderModelFromSDKv2ProviderConfig(meta.Config),
+			Client:       &meta.Client,
+			Config:       helper.GetFrameworkProviderModelFromSDKv2ProviderConfig(meta.Config),
+			EventWatcher: meta.EventWatcher,
+			LinodeMutex:  meta.LinodeMutex,
 		},
 	}
 }
@@ -120,6 +139,22 @@ func (p *FrameworkProvider) Schema(
 				Optional:    true,
 				Description: "The token that allows you access to your Linode account",
 			},
+			"token_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path to a file containing the token that allows you access to your Linode account.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("token_command")),
+				},
+			},
+			"token_command": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "A command and its arguments to run to get the token that allows you access to your Linode account.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("token_file")),
+				},
+			},
 			"config_path": schema.StringAttribute{
 				Optional:    true,
 				Description: "The path to the Linode config file to use. (default `~/.config/linode`)",
@@ -140,6 +175,10 @@ func (p *FrameworkProvider) Schema(
 				Optional:    true,
 				Description: "The version of Linode API.",
 			},
+			"child_account_euuid": schema.StringAttribute{
+				Optional:    true,
+				Description: "The EUUID of a child account to manage resources in using a proxy token.",
+			},
 			"skip_instance_ready_poll": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Skip waiting for a linode_instance resource to be running.",
@@ -176,6 +215,39 @@ func (p *FrameworkProvider) Schema(
 				Optional:    true,
 				Description: "The rate in milliseconds to poll for an LKE node to be ready.",
 			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of concurrent requests to the Linode API.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "The maximum number of requests per second to the Linode API.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"default_tags": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Tags to apply to all taggable resources managed by this provider.",
+			},
+			"ignore_tags": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Tag prefixes to ignore on all taggable resources managed by this provider.",
+			},
+			"audit_log_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path of a file to append a JSON line to for each API request mutating the account.",
+			},
+			"read_only": schema.BoolAttribute{
+				Optional: true,
+				Description: "If true, the provider refuses to send any API request other than GET requests, " +
+					"guaranteeing the account is not modified.",
+			},
 			"obj_access_key": schema.StringAttribute{
 				Optional:    true,
 				Description: "The access key to be used in linode_object_storage_bucket and linode_object_storage_object.",
@@ -196,6 +268,35 @@ func (p *FrameworkProvider) Schema(
 					"and versions will be force deleted.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"retry_rule": schema.ListNestedBlock{
+				Description: "An additional rule retrying failed requests to the Linode API.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"status_codes": schema.SetAttribute{
+							ElementType: types.Int64Type,
+							Required:    true,
+							Description: "The HTTP status codes of the responses to retry.",
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueInt64sAre(int64validator.Between(100, 599)),
+							},
+						},
+						"path_regex": schema.StringAttribute{
+							Required:    true,
+							Description: "A regular expression matching the paths of the requests to retry.",
+						},
+						"max_attempts": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of attempts of a matching request. (default `5`)",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -222,6 +323,26 @@ func (p *FrameworkProvider) Resources(ctx context.Context) []func() resource.Res
 		firewall.NewResource,
 		placementgroup.NewResource,
 		placementgroupassignment.NewResource,
+		instanceclone.NewResource,
+		instancesnapshot.NewResource,
+		instancebackuprestore.NewResource,
+	}
+}
+
+func (p *FrameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		databasemysql.NewEphemeralResource,
+		databasepostgresql.NewEphemeralResource,
+		lkekubeconfig.NewEphemeralResource,
+		objtempkey.NewEphemeralResource,
+	}
+}
+
+func (p *FrameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		parsekubeconfig.NewFunction,
+		zonefile.NewParseFunction,
+		zonefile.NewRenderFunction,
 	}
 }
 
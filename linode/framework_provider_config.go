@@ -16,7 +16,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 )
@@ -46,6 +45,7 @@ func (fp *FrameworkProvider) Configure(
 
 	resp.ResourceData = &meta
 	resp.DataSourceData = &meta
+	resp.EphemeralResourceData = &meta
 
 	fp.Meta = &meta
 }
@@ -157,6 +157,10 @@ func (fp *FrameworkProvider) HandleDefaults(
 		)
 	}
 
+	if lpm.ChildAccountEUUID.IsNull() {
+		lpm.ChildAccountEUUID = GetStringFromEnv("LINODE_CHILD_ACCOUNT_EUUID", types.StringNull())
+	}
+
 	if lpm.SkipInstanceReadyPoll.IsNull() {
 		lpm.SkipInstanceReadyPoll = types.BoolValue(false)
 	}
@@ -189,6 +193,14 @@ func (fp *FrameworkProvider) HandleDefaults(
 		lpm.LKENodeReadyPollMilliseconds = types.Int64Value(3000)
 	}
 
+	if lpm.MaxConcurrentRequests.IsNull() {
+		lpm.MaxConcurrentRequests = types.Int64Value(0)
+	}
+
+	if lpm.RequestsPerSecond.IsNull() {
+		lpm.RequestsPerSecond = types.Float64Value(0)
+	}
+
 	if lpm.ObjAccessKey.IsNull() {
 		lpm.ObjAccessKey = GetStringFromEnv(
 			"LINODE_OBJ_ACCESS_KEY",
@@ -226,21 +238,60 @@ func (fp *FrameworkProvider) InitProvider(
 		tflog.Info(ctx, "Linode client was already configured, re-using..")
 		meta.Client = fp.Meta.Client
 		meta.Config = fp.Meta.Config
+		meta.EventWatcher = fp.Meta.EventWatcher
+		meta.LinodeMutex = fp.Meta.LinodeMutex
 		return
 	}
 
-	loggingTransport := helper.NewAPILoggerTransport(
-		logging.NewSubsystemLoggingHTTPTransport(
-			helper.APILoggerSubsystem,
-			http.DefaultTransport,
-		),
+	transport, err := helper.NewAPITransport(
+		int(lpm.MaxConcurrentRequests.ValueInt64()),
+		lpm.RequestsPerSecond.ValueFloat64(),
 	)
+	if err != nil {
+		diags.AddError("Failed to configure the API client transport.", err.Error())
+		return
+	}
+
+	if lpm.ReadOnly.ValueBool() {
+		transport = helper.NewReadOnlyTransport(transport)
+	}
+
+	// The audit log wraps the read-only transport
+	// so refused requests are also recorded.
+	if auditLogPath := lpm.AuditLogPath.ValueString(); auditLogPath != "" {
+		transport, err = helper.NewAuditLogTransport(transport, auditLogPath)
+		if err != nil {
+			diags.AddError("Failed to configure the audit log.", err.Error())
+			return
+		}
+	}
+
+	var childAccountTransport *helper.ChildAccountTransport
+	if euuid := lpm.ChildAccountEUUID.ValueString(); euuid != "" {
+		childAccountTransport = helper.NewChildAccountTransport(transport, euuid)
+		transport = childAccountTransport
+	}
+
+	var tokenCommand []string
+	if !lpm.TokenCommand.IsNull() {
+		diags.Append(lpm.TokenCommand.ElementsAs(ctx, &tokenCommand, false)...)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	// The token command transport must wrap the child account transport
+	// so proxy tokens are created using the token printed by the command.
+	if len(tokenCommand) > 0 {
+		transport = helper.NewTokenCommandTransport(transport, tokenCommand)
+	}
 
 	oauth2Client := &http.Client{
-		Transport: loggingTransport,
+		Transport: transport,
 	}
 
 	accessToken := lpm.AccessToken.ValueString()
+	tokenFile := lpm.TokenFile.ValueString()
 	APIURL := lpm.APIURL.ValueString()
 	APIVersion := lpm.APIVersion.ValueString()
 	UAPrefix := lpm.UAPrefix.ValueString()
@@ -278,7 +329,17 @@ func (fp *FrameworkProvider) InitProvider(
 	}
 
 	// Overrides
-	if accessToken != "" {
+	switch {
+	case tokenFile != "":
+		token, err := helper.ReadTokenFile(tokenFile)
+		if err != nil {
+			diags.AddError("Failed to read the token file.", err.Error())
+			return
+		}
+		client.SetToken(token)
+	case len(tokenCommand) > 0:
+		// The token is set by the token command transport
+	case accessToken != "":
 		client.SetToken(accessToken)
 	}
 
@@ -308,8 +369,27 @@ func (fp *FrameworkProvider) InitProvider(
 
 	helper.ApplyAllRetryConditions(&client)
 
+	retryRules := make([]helper.RetryRule, len(lpm.RetryRules))
+	for i, rule := range lpm.RetryRules {
+		retryRules[i] = rule.ToRetryRule(diags)
+	}
+	if diags.HasError() {
+		return
+	}
+
+	if err := helper.ApplyRetryRules(&client, retryRules); err != nil {
+		diags.AddError("Failed to configure the retry rules.", err.Error())
+		return
+	}
+
+	if childAccountTransport != nil {
+		childAccountTransport.SetParentClient(&client)
+	}
+
 	meta.Config = lpm
 	meta.Client = &client
+	meta.EventWatcher = helper.NewEventWatcher(&client)
+	meta.LinodeMutex = helper.LinodeMutex()
 }
 
 func (fp *FrameworkProvider) terraformUserAgent(
@@ -0,0 +1,157 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/linode/linodego"
+)
+
+// apiErrorReasonSeparator separates the reasons of an API error in the
+// message of a linodego.Error, as joined by linodego.APIError.
+const apiErrorReasonSeparator = "; "
+
+var (
+	// apiErrorReasonRegex matches a reason for a specific field,
+	// e.g. `[root_pass] Password does not meet strength requirement.`
+	apiErrorReasonRegex = regexp.MustCompile(`^\[(.+?)\] (.*)$`)
+
+	// apiErrorFieldStepRegex matches a step of a field of an API error,
+	// e.g. `interfaces[0]` or `ipv4`.
+	apiErrorFieldStepRegex = regexp.MustCompile(`^([a-zA-Z0-9_]+)((?:\[[0-9]+\])*)$`)
+
+	apiErrorFieldIndexRegex = regexp.MustCompile(`\[([0-9]+)\]`)
+)
+
+// APIErrorReasons returns the individual reasons of the given Linode API error,
+// or nil if the error was not returned by the Linode API.
+//
+// The reasons are recovered from the message of the error because linodego
+// has already consumed the response body by the time the error is returned.
+// A reason that itself contains the "; " separator is therefore split into
+// several reasons, and only the first of them keeps its field.
+func APIErrorReasons(err error) []linodego.APIErrorReason {
+	var message string
+
+	var lErr *linodego.Error
+	var lErrValue linodego.Error
+
+	switch {
+	case errors.As(err, &lErr):
+		message = lErr.Message
+	case errors.As(err, &lErrValue):
+		message = lErrValue.Message
+	default:
+		return nil
+	}
+
+	if message == "" {
+		return nil
+	}
+
+	parts := strings.Split(message, apiErrorReasonSeparator)
+	result := make([]linodego.APIErrorReason, len(parts))
+
+	for i, part := range parts {
+		if match := apiErrorReasonRegex.FindStringSubmatch(part); match != nil {
+			result[i] = linodego.APIErrorReason{Field: match[1], Reason: match[2]}
+			continue
+		}
+
+		result[i] = linodego.APIErrorReason{Reason: part}
+	}
+
+	return result
+}
+
+// APIErrorFieldToPath converts the field of an API error reason into
+// an attribute path, e.g. `interfaces[0].purpose` is converted into
+// path.Root("interfaces").AtListIndex(0).AtName("purpose").
+func APIErrorFieldToPath(field string) (path.Path, bool) {
+	var result path.Path
+
+	for i, step := range strings.Split(field, ".") {
+		match := apiErrorFieldStepRegex.FindStringSubmatch(step)
+		if match == nil {
+			return path.Empty(), false
+		}
+
+		if i == 0 {
+			result = path.Root(match[1])
+		} else {
+			result = result.AtName(match[1])
+		}
+
+		for _, index := range apiErrorFieldIndexRegex.FindAllStringSubmatch(match[2], -1) {
+			indexValue, err := strconv.Atoi(index[1])
+			if err != nil {
+				return path.Empty(), false
+			}
+
+			result = result.AtListIndex(indexValue)
+		}
+	}
+
+	return result, true
+}
+
+// FrameworkAddAPIError adds the given error to the diagnostics. Reasons of
+// Linode API errors for fields matching an attribute of the given plan are
+// added as attribute errors so Terraform can point at the offending
+// attribute, and all other reasons are added as a single error.
+func FrameworkAddAPIError(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	plan tfsdk.Plan,
+	summary string,
+	err error,
+) {
+	reasons := APIErrorReasons(err)
+
+	var unmatched []string
+
+	for _, reason := range reasons {
+		if attrPath, ok := planAttributePath(ctx, plan, reason.Field); ok {
+			diags.AddAttributeError(attrPath, summary, reason.Reason)
+			continue
+		}
+
+		unmatched = append(unmatched, reason.Error())
+	}
+
+	if reasons == nil {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	if len(unmatched) > 0 {
+		diags.AddError(summary, strings.Join(unmatched, apiErrorReasonSeparator))
+	}
+}
+
+// planAttributePath returns the path of the attribute of the plan
+// matching the given API error field, if any.
+func planAttributePath(ctx context.Context, plan tfsdk.Plan, field string) (path.Path, bool) {
+	if field == "" || plan.Raw.IsNull() {
+		return path.Empty(), false
+	}
+
+	attrPath, ok := APIErrorFieldToPath(field)
+	if !ok {
+		return path.Empty(), false
+	}
+
+	// Paths not defined in the schema, or steps into nested values that are
+	// not lists, are reported as diagnostics rather than matches.
+	if _, d := plan.PathMatches(ctx, attrPath.Expression()); d.HasError() {
+		return path.Empty(), false
+	}
+
+	return attrPath, true
+}
@@ -0,0 +1,143 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIErrorReasons(t *testing.T) {
+	err := fmt.Errorf("failed: %w", &linodego.Error{
+		Code:    400,
+		Message: "[label] Label too long; Something went wrong; [interfaces[0].purpose] Invalid purpose",
+	})
+
+	assert.Equal(t, []linodego.APIErrorReason{
+		{Field: "label", Reason: "Label too long"},
+		{Reason: "Something went wrong"},
+		{Field: "interfaces[0].purpose", Reason: "Invalid purpose"},
+	}, helper.APIErrorReasons(err))
+
+	assert.Equal(t,
+		[]linodego.APIErrorReason{{Reason: "Not found"}},
+		helper.APIErrorReasons(linodego.Error{Code: 404, Message: "Not found"}),
+	)
+
+	assert.Nil(t, helper.APIErrorReasons(errors.New("not an API error")))
+}
+
+func TestAPIErrorFieldToPath(t *testing.T) {
+	testCases := map[string]path.Path{
+		"label":                 path.Root("label"),
+		"interfaces[0].purpose": path.Root("interfaces").AtListIndex(0).AtName("purpose"),
+		"ipv4.nat_1_1":          path.Root("ipv4").AtName("nat_1_1"),
+	}
+
+	for field, expected := range testCases {
+		result, ok := helper.APIErrorFieldToPath(field)
+		assert.True(t, ok, field)
+		assert.Equal(t, expected, result, field)
+	}
+
+	_, ok := helper.APIErrorFieldToPath("devices/sda")
+	assert.False(t, ok)
+}
+
+func TestFrameworkAddAPIError(t *testing.T) {
+	ctx := context.Background()
+
+	// Respond to all requests with the given API errors
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"errors": [
+			{"field": "root_pass", "reason": "Password does not meet strength requirement."},
+			{"field": "interfaces[0].label", "reason": "Label is invalid."},
+			{"field": "unknown_field", "reason": "Unknown field is invalid."},
+			{"reason": "Something went wrong."}
+		]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := linodego.NewClient(http.DefaultClient)
+	client.SetBaseURL(server.URL)
+	client.SetRetryCount(0)
+
+	_, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{})
+	require.Error(t, err)
+
+	interfaceType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"label": tftypes.String}}
+	planType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"root_pass":  tftypes.String,
+		"interfaces": tftypes.List{ElementType: interfaceType},
+	}}
+
+	plan := tfsdk.Plan{
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"root_pass": schema.StringAttribute{Optional: true},
+				"interfaces": schema.ListNestedAttribute{
+					Optional: true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"label": schema.StringAttribute{Optional: true},
+						},
+					},
+				},
+			},
+		},
+		Raw: tftypes.NewValue(planType, map[string]tftypes.Value{
+			"root_pass": tftypes.NewValue(tftypes.String, "weak"),
+			"interfaces": tftypes.NewValue(tftypes.List{ElementType: interfaceType}, []tftypes.Value{
+				tftypes.NewValue(interfaceType, map[string]tftypes.Value{
+					"label": tftypes.NewValue(tftypes.String, "?"),
+				}),
+			}),
+		}),
+	}
+
+	var diags diag.Diagnostics
+	helper.FrameworkAddAPIError(ctx, &diags, plan, "Failed to Create Instance", err)
+
+	require.Len(t, diags, 3)
+
+	assert.Equal(t, diag.NewAttributeErrorDiagnostic(
+		path.Root("root_pass"),
+		"Failed to Create Instance",
+		"Password does not meet strength requirement.",
+	), diags[0])
+
+	assert.Equal(t, diag.NewAttributeErrorDiagnostic(
+		path.Root("interfaces").AtListIndex(0).AtName("label"),
+		"Failed to Create Instance",
+		"Label is invalid.",
+	), diags[1])
+
+	assert.Equal(t, diag.NewErrorDiagnostic(
+		"Failed to Create Instance",
+		"[unknown_field] Unknown field is invalid.; Something went wrong.",
+	), diags[2])
+
+	// Errors not returned by the API are added as-is
+	diags = nil
+	helper.FrameworkAddAPIError(ctx, &diags, plan, "Failed to Create Instance", errors.New("oops"))
+
+	assert.Equal(t, diag.Diagnostics{
+		diag.NewErrorDiagnostic("Failed to Create Instance", "oops"),
+	}, diags)
+}
@@ -0,0 +1,239 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiRequestServer wraps a provider server to log the details of failed
+// Linode API requests and append them to the error diagnostics of each RPC,
+// so the request IDs can be provided to Linode support without rerunning
+// with debug logs.
+// API requests are also associated with the resource type of the RPC.
+type apiRequestServer struct {
+	tfprotov5.ProviderServer
+}
+
+// NewAPIRequestServer returns a provider server appending the failed
+// Linode API requests of each RPC to its error diagnostics. The returned
+// server also implements tfprotov5.ProviderServerWithEphemeralResources.
+func NewAPIRequestServer(server tfprotov5.ProviderServer) tfprotov5.ProviderServer {
+	return &apiRequestServer{ProviderServer: server}
+}
+
+func (s *apiRequestServer) ConfigureProvider(
+	ctx context.Context,
+	req *tfprotov5.ConfigureProviderRequest,
+) (*tfprotov5.ConfigureProviderResponse, error) {
+	ctx = WithAPIRequestTracking(ctx)
+
+	resp, err := s.ProviderServer.ConfigureProvider(ctx, req)
+	if resp != nil {
+		reportFailedAPIRequests(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+func (s *apiRequestServer) ReadResource(
+	ctx context.Context,
+	req *tfprotov5.ReadResourceRequest,
+) (*tfprotov5.ReadResourceResponse, error) {
+	ctx = WithAPIRequestResource(WithAPIRequestTracking(ctx), req.TypeName)
+
+	resp, err := s.ProviderServer.ReadResource(ctx, req)
+	if resp != nil {
+		reportFailedAPIRequests(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+func (s *apiRequestServer) PlanResourceChange(
+	ctx context.Context,
+	req *tfprotov5.PlanResourceChangeRequest,
+) (*tfprotov5.PlanResourceChangeResponse, error) {
+	ctx = WithAPIRequestResource(WithAPIRequestTracking(ctx), req.TypeName)
+
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if resp != nil {
+		reportFailedAPIRequests(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+func (s *apiRequestServer) ApplyResourceChange(
+	ctx context.Context,
+	req *tfprotov5.ApplyResourceChangeRequest,
+) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	ctx = WithAPIRequestResource(WithAPIRequestTracking(ctx), req.TypeName)
+
+	resp, err := s.ProviderServer.ApplyResourceChange(ctx, req)
+	if resp != nil {
+		reportFailedAPIRequests(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+func (s *apiRequestServer) ImportResourceState(
+	ctx context.Context,
+	req *tfprotov5.ImportResourceStateRequest,
+) (*tfprotov5.ImportResourceStateResponse, error) {
+	ctx = WithAPIRequestResource(WithAPIRequestTracking(ctx), req.TypeName)
+
+	resp, err := s.ProviderServer.ImportResourceState(ctx, req)
+	if resp != nil {
+		reportFailedAPIRequests(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+func (s *apiRequestServer) ReadDataSource(
+	ctx context.Context,
+	req *tfprotov5.ReadDataSourceRequest,
+) (*tfprotov5.ReadDataSourceResponse, error) {
+	ctx = WithAPIRequestResource(WithAPIRequestTracking(ctx), req.TypeName)
+
+	resp, err := s.ProviderServer.ReadDataSource(ctx, req)
+	if resp != nil {
+		reportFailedAPIRequests(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+func (s *apiRequestServer) ValidateEphemeralResourceConfig(
+	ctx context.Context,
+	req *tfprotov5.ValidateEphemeralResourceConfigRequest,
+) (*tfprotov5.ValidateEphemeralResourceConfigResponse, error) {
+	server, ok := s.ProviderServer.(tfprotov5.EphemeralResourceServer)
+	if !ok {
+		return &tfprotov5.ValidateEphemeralResourceConfigResponse{
+			Diagnostics: ephemeralResourcesNotImplementedDiagnostics("ValidateEphemeralResourceConfig"),
+		}, nil
+	}
+
+	ctx = WithAPIRequestResource(WithAPIRequestTracking(ctx), req.TypeName)
+
+	resp, err := server.ValidateEphemeralResourceConfig(ctx, req)
+	if resp != nil {
+		reportFailedAPIRequests(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+func (s *apiRequestServer) OpenEphemeralResource(
+	ctx context.Context,
+	req *tfprotov5.OpenEphemeralResourceRequest,
+) (*tfprotov5.OpenEphemeralResourceResponse, error) {
+	server, ok := s.ProviderServer.(tfprotov5.EphemeralResourceServer)
+	if !ok {
+		return &tfprotov5.OpenEphemeralResourceResponse{
+			Diagnostics: ephemeralResourcesNotImplementedDiagnostics("OpenEphemeralResource"),
+		}, nil
+	}
+
+	ctx = WithAPIRequestResource(WithAPIRequestTracking(ctx), req.TypeName)
+
+	resp, err := server.OpenEphemeralResource(ctx, req)
+	if resp != nil {
+		reportFailedAPIRequests(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+func (s *apiRequestServer) RenewEphemeralResource(
+	ctx context.Context,
+	req *tfprotov5.RenewEphemeralResourceRequest,
+) (*tfprotov5.RenewEphemeralResourceResponse, error) {
+	server, ok := s.ProviderServer.(tfprotov5.EphemeralResourceServer)
+	if !ok {
+		return &tfprotov5.RenewEphemeralResourceResponse{
+			Diagnostics: ephemeralResourcesNotImplementedDiagnostics("RenewEphemeralResource"),
+		}, nil
+	}
+
+	ctx = WithAPIRequestResource(WithAPIRequestTracking(ctx), req.TypeName)
+
+	resp, err := server.RenewEphemeralResource(ctx, req)
+	if resp != nil {
+		reportFailedAPIRequests(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+func (s *apiRequestServer) CloseEphemeralResource(
+	ctx context.Context,
+	req *tfprotov5.CloseEphemeralResourceRequest,
+) (*tfprotov5.CloseEphemeralResourceResponse, error) {
+	server, ok := s.ProviderServer.(tfprotov5.EphemeralResourceServer)
+	if !ok {
+		return &tfprotov5.CloseEphemeralResourceResponse{
+			Diagnostics: ephemeralResourcesNotImplementedDiagnostics("CloseEphemeralResource"),
+		}, nil
+	}
+
+	ctx = WithAPIRequestResource(WithAPIRequestTracking(ctx), req.TypeName)
+
+	resp, err := server.CloseEphemeralResource(ctx, req)
+	if resp != nil {
+		reportFailedAPIRequests(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ephemeralResourcesNotImplementedDiagnostics returns the diagnostics of an
+// ephemeral resource RPC received when the wrapped server does not implement
+// ephemeral resources.
+func ephemeralResourcesNotImplementedDiagnostics(rpc string) []*tfprotov5.Diagnostic {
+	return []*tfprotov5.Diagnostic{
+		{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  fmt.Sprintf("%s Not Implemented", rpc),
+			Detail: fmt.Sprintf(
+				"A %s call was received by the provider, however the provider server "+
+					"does not implement ephemeral resources.",
+				rpc,
+			),
+		},
+	}
+}
+
+// reportFailedAPIRequests logs the failed API requests made with the given
+// context and appends them to the detail of each error diagnostic.
+//
+// The request details can't be added to the log context of the resource
+// making the requests, as contexts are immutable, so they are logged with
+// the context of the RPC instead, which carries the same resource fields.
+func reportFailedAPIRequests(ctx context.Context, diags []*tfprotov5.Diagnostic) {
+	requests := FailedAPIRequests(ctx)
+	if len(requests) == 0 {
+		return
+	}
+
+	for _, r := range requests {
+		tflog.Warn(ctx, "Linode API request failed", map[string]any{
+			"request_id": r.RequestID,
+			"status":     r.Status,
+			"endpoint":   fmt.Sprintf("%s %s", r.Method, r.Endpoint),
+		})
+	}
+
+	for _, d := range diags {
+		if d == nil || d.Severity != tfprotov5.DiagnosticSeverityError {
+			continue
+		}
+
+		d.Detail = AppendFailedAPIRequests(d.Detail, requests)
+	}
+}
@@ -0,0 +1,152 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// APIRequestIDHeader is the header of a Linode API response
+// identifying the request for Linode support.
+const APIRequestIDHeader = "X-Request-Id"
+
+// APIRequestInfo describes the response of a single Linode API request.
+type APIRequestInfo struct {
+	RequestID string
+	Method    string
+	Endpoint  string
+	Status    int
+}
+
+// NewAPIRequestInfo returns the details of the given API request and response.
+func NewAPIRequestInfo(req *http.Request, resp *http.Response) APIRequestInfo {
+	return APIRequestInfo{
+		RequestID: resp.Header.Get(APIRequestIDHeader),
+		Method:    req.Method,
+		Endpoint:  req.URL.Path,
+		Status:    resp.StatusCode,
+	}
+}
+
+// Failed returns whether the API request failed.
+func (i APIRequestInfo) Failed() bool {
+	return i.Status >= http.StatusBadRequest
+}
+
+func (i APIRequestInfo) String() string {
+	requestID := i.RequestID
+	if requestID == "" {
+		requestID = "unknown"
+	}
+
+	return fmt.Sprintf(
+		"%s %s: %d %s (Request ID: %s)",
+		i.Method, i.Endpoint, i.Status, http.StatusText(i.Status), requestID,
+	)
+}
+
+type (
+	apiRequestTrackerKey  struct{}
+	apiRequestResourceKey struct{}
+)
+
+// WithAPIRequestResource returns a context for API requests made on behalf
+// of the Terraform resource or data source of the given type.
+func WithAPIRequestResource(ctx context.Context, typeName string) context.Context {
+	return context.WithValue(ctx, apiRequestResourceKey{}, typeName)
+}
+
+// APIRequestResource returns the type of the Terraform resource or data source
+// API requests with the given context are made for, if known.
+func APIRequestResource(ctx context.Context) string {
+	result, _ := ctx.Value(apiRequestResourceKey{}).(string)
+	return result
+}
+
+// apiRequestTracker records the failed API requests made
+// while handling a single provider RPC.
+type apiRequestTracker struct {
+	mu     sync.Mutex
+	failed []APIRequestInfo
+}
+
+// WithAPIRequestTracking returns a context recording the failed API
+// requests made with it, see FailedAPIRequests.
+func WithAPIRequestTracking(ctx context.Context) context.Context {
+	return context.WithValue(ctx, apiRequestTrackerKey{}, &apiRequestTracker{})
+}
+
+// FailedAPIRequests returns the API requests made with the given context
+// that failed and were not successfully retried.
+func FailedAPIRequests(ctx context.Context) []APIRequestInfo {
+	tracker, ok := ctx.Value(apiRequestTrackerKey{}).(*apiRequestTracker)
+	if !ok {
+		return nil
+	}
+
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	if len(tracker.failed) == 0 {
+		return nil
+	}
+
+	result := make([]APIRequestInfo, len(tracker.failed))
+	copy(result, tracker.failed)
+
+	return result
+}
+
+// trackAPIRequest records the given API request in the tracker
+// of the given context, if any.
+func trackAPIRequest(ctx context.Context, info APIRequestInfo) {
+	tracker, ok := ctx.Value(apiRequestTrackerKey{}).(*apiRequestTracker)
+	if !ok {
+		return
+	}
+
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	// Drop earlier failures of the same request,
+	// e.g. when a request was retried.
+	failed := tracker.failed[:0]
+	for _, f := range tracker.failed {
+		if f.Method != info.Method || f.Endpoint != info.Endpoint {
+			failed = append(failed, f)
+		}
+	}
+
+	if info.Failed() {
+		failed = append(failed, info)
+	}
+
+	tracker.failed = failed
+}
+
+// AppendFailedAPIRequests appends the given failed API requests to the
+// detail of a diagnostic so they can be provided to Linode support.
+func AppendFailedAPIRequests(detail string, requests []APIRequestInfo) string {
+	if len(requests) == 0 {
+		return detail
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(detail)
+
+	if detail != "" {
+		sb.WriteString("\n\n")
+	}
+
+	sb.WriteString("Failed Linode API requests:")
+
+	for _, r := range requests {
+		sb.WriteString("\n  - ")
+		sb.WriteString(r.String())
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,214 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAPIRequestTestClient(t *testing.T) *linodego.Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set(helper.APIRequestIDHeader, "request-"+r.Method)
+
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Something went wrong."}]}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id": 123}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := linodego.NewClient(&http.Client{
+		Transport: helper.NewAPILoggerTransport(http.DefaultTransport),
+	})
+	client.SetBaseURL(server.URL)
+	client.SetRetryCount(0)
+
+	return &client
+}
+
+func TestFailedAPIRequests(t *testing.T) {
+	client := newAPIRequestTestClient(t)
+
+	ctx := helper.WithAPIRequestTracking(context.Background())
+
+	_, err := client.GetInstance(ctx, 123)
+	require.NoError(t, err)
+	assert.Empty(t, helper.FailedAPIRequests(ctx))
+
+	_, err = client.CreateInstance(ctx, linodego.InstanceCreateOptions{})
+	require.Error(t, err)
+
+	assert.Equal(t, []helper.APIRequestInfo{
+		{
+			RequestID: "request-POST",
+			Method:    http.MethodPost,
+			Endpoint:  "/v4/linode/instances",
+			Status:    http.StatusBadRequest,
+		},
+	}, helper.FailedAPIRequests(ctx))
+
+	// Requests are not tracked without a tracking context
+	_, err = client.CreateInstance(context.Background(), linodego.InstanceCreateOptions{})
+	require.Error(t, err)
+	assert.Empty(t, helper.FailedAPIRequests(context.Background()))
+}
+
+func TestAppendFailedAPIRequests(t *testing.T) {
+	requests := []helper.APIRequestInfo{
+		{RequestID: "abc", Method: "POST", Endpoint: "/v4/linode/instances", Status: 400},
+		{Method: "GET", Endpoint: "/v4/linode/instances/123", Status: 500},
+	}
+
+	assert.Equal(t,
+		"oops\n\nFailed Linode API requests:\n"+
+			"  - POST /v4/linode/instances: 400 Bad Request (Request ID: abc)\n"+
+			"  - GET /v4/linode/instances/123: 500 Internal Server Error (Request ID: unknown)",
+		helper.AppendFailedAPIRequests("oops", requests),
+	)
+
+	assert.Equal(t, "oops", helper.AppendFailedAPIRequests("oops", nil))
+}
+
+type apiRequestTestServer struct {
+	tfprotov5.ProviderServer
+
+	client *linodego.Client
+}
+
+func (s *apiRequestTestServer) ApplyResourceChange(
+	ctx context.Context,
+	_ *tfprotov5.ApplyResourceChangeRequest,
+) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	resp := &tfprotov5.ApplyResourceChangeResponse{
+		Diagnostics: []*tfprotov5.Diagnostic{
+			{Severity: tfprotov5.DiagnosticSeverityWarning, Summary: "Warning"},
+		},
+	}
+
+	if _, err := s.client.CreateInstance(ctx, linodego.InstanceCreateOptions{}); err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to create instance",
+			Detail:   err.Error(),
+		})
+	}
+
+	return resp, nil
+}
+
+func TestAPIRequestServer(t *testing.T) {
+	server := helper.NewAPIRequestServer(&apiRequestTestServer{
+		client: newAPIRequestTestClient(t),
+	})
+
+	resp, err := server.ApplyResourceChange(context.Background(), &tfprotov5.ApplyResourceChangeRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Diagnostics, 2)
+
+	assert.Empty(t, resp.Diagnostics[0].Detail)
+	assert.Contains(t, resp.Diagnostics[1].Detail, "Something went wrong.")
+	assert.Contains(t, resp.Diagnostics[1].Detail,
+		"POST /v4/linode/instances: 400 Bad Request (Request ID: request-POST)")
+}
+
+func TestAPIRequestServer_logsFailedRequests(t *testing.T) {
+	var output bytes.Buffer
+
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	server := helper.NewAPIRequestServer(&apiRequestTestServer{
+		client: newAPIRequestTestClient(t),
+	})
+
+	_, err := server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+		TypeName: "linode_instance",
+	})
+	require.NoError(t, err)
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+
+	var logged []map[string]any
+	for _, entry := range entries {
+		if entry["@message"] == "Linode API request failed" && entry["@level"] == "warn" {
+			logged = append(logged, entry)
+		}
+	}
+
+	require.Len(t, logged, 1)
+	assert.Equal(t, "request-POST", logged[0]["request_id"])
+	assert.EqualValues(t, http.StatusBadRequest, logged[0]["status"])
+	assert.Equal(t, "POST /v4/linode/instances", logged[0]["endpoint"])
+}
+
+type apiRequestEphemeralTestServer struct {
+	apiRequestTestServer
+	tfprotov5.EphemeralResourceServer
+}
+
+func (s *apiRequestEphemeralTestServer) OpenEphemeralResource(
+	ctx context.Context,
+	_ *tfprotov5.OpenEphemeralResourceRequest,
+) (*tfprotov5.OpenEphemeralResourceResponse, error) {
+	resp := &tfprotov5.OpenEphemeralResourceResponse{}
+
+	if _, err := s.client.CreateInstance(ctx, linodego.InstanceCreateOptions{}); err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to create instance",
+			Detail:   err.Error(),
+		})
+	}
+
+	return resp, nil
+}
+
+func TestAPIRequestServer_ephemeralResources(t *testing.T) {
+	server := helper.NewAPIRequestServer(&apiRequestEphemeralTestServer{
+		apiRequestTestServer: apiRequestTestServer{client: newAPIRequestTestClient(t)},
+	})
+
+	ephemeralServer, ok := server.(tfprotov5.ProviderServerWithEphemeralResources)
+	require.True(t, ok, "server does not implement ephemeral resources")
+
+	resp, err := ephemeralServer.OpenEphemeralResource(
+		context.Background(),
+		&tfprotov5.OpenEphemeralResourceRequest{TypeName: "linode_test"},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Diagnostics, 1)
+
+	assert.Contains(t, resp.Diagnostics[0].Detail,
+		"POST /v4/linode/instances: 400 Bad Request (Request ID: request-POST)")
+}
+
+func TestAPIRequestServer_ephemeralResourcesNotImplemented(t *testing.T) {
+	server := helper.NewAPIRequestServer(&apiRequestTestServer{
+		client: newAPIRequestTestClient(t),
+	})
+
+	resp, err := server.(tfprotov5.ProviderServerWithEphemeralResources).CloseEphemeralResource(
+		context.Background(),
+		&tfprotov5.CloseEphemeralResourceRequest{TypeName: "linode_test"},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Diagnostics, 1)
+	assert.Equal(t, "CloseEphemeralResource Not Implemented", resp.Diagnostics[0].Summary)
+}
@@ -0,0 +1,136 @@
+package helper
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// AuditLogEntry is a single line of the audit log describing
+// an API request mutating the account. Terraform does not provide
+// resource addresses to providers, so only the type of the resource
+// or data source making the request is recorded.
+type AuditLogEntry struct {
+	Timestamp    time.Time       `json:"timestamp"`
+	Method       string          `json:"method"`
+	Path         string          `json:"path"`
+	Body         json.RawMessage `json:"body,omitempty"`
+	Status       int             `json:"status,omitempty"`
+	Error        string          `json:"error,omitempty"`
+	ResourceType string          `json:"resource_type,omitempty"`
+}
+
+// auditLog is an append-only audit log file shared between all
+// clients writing to the same path, e.g. the SDKv2 and framework providers.
+type auditLog struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+var (
+	auditLogsMu sync.Mutex
+	auditLogs   = make(map[string]*auditLog)
+)
+
+func getAuditLog(path string) (*auditLog, error) {
+	auditLogsMu.Lock()
+	defer auditLogsMu.Unlock()
+
+	if result, ok := auditLogs[path]; ok {
+		return result, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log %s: %w", path, err)
+	}
+
+	result := &auditLog{file: file}
+	auditLogs[path] = result
+
+	return result, nil
+}
+
+func (l *auditLog) write(entry AuditLogEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	_, err = l.file.Write(append(line, '\n'))
+	return err
+}
+
+// AuditLogTransport appends an entry to an audit log file
+// for each API request mutating the account.
+type AuditLogTransport struct {
+	transport http.RoundTripper
+	log       *auditLog
+}
+
+// NewAuditLogTransport is a RoundTripper used to record POST, PUT and DELETE
+// API requests as JSON lines appended to the file at the given path.
+func NewAuditLogTransport(transport http.RoundTripper, path string) (*AuditLogTransport, error) {
+	log, err := getAuditLog(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuditLogTransport{
+		transport: transport,
+		log:       log,
+	}, nil
+}
+
+// RoundTrip passes the request to the underlying transport and records
+// it in the audit log if it mutates the account.
+func (t *AuditLogTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	switch r.Method {
+	case http.MethodPost, http.MethodPut, http.MethodDelete:
+	default:
+		return t.transport.RoundTrip(r)
+	}
+
+	entry := AuditLogEntry{
+		Timestamp:    time.Now().UTC(),
+		Method:       r.Method,
+		Path:         r.URL.Path,
+		ResourceType: APIRequestResource(r.Context()),
+	}
+
+	if r.Body != nil && r.Body != http.NoBody {
+		body, err := io.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		entry.Body = redactJSONBody(body)
+	}
+
+	resp, err := t.transport.RoundTrip(r)
+	if err != nil {
+		entry.Error = err.Error()
+	} else {
+		entry.Status = resp.StatusCode
+	}
+
+	if logErr := t.log.write(entry); logErr != nil {
+		tflog.Warn(r.Context(), "Failed to write to the audit log", map[string]any{
+			"error": logErr.Error(),
+		})
+	}
+
+	return resp, err
+}
@@ -0,0 +1,101 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLogTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id": 123}`))
+	}))
+	t.Cleanup(server.Close)
+
+	logPath := filepath.Join(t.TempDir(), "audit.log")
+
+	transport, err := helper.NewAuditLogTransport(http.DefaultTransport, logPath)
+	require.NoError(t, err)
+
+	client := linodego.NewClient(&http.Client{Transport: transport})
+	client.SetBaseURL(server.URL)
+	client.SetRetryCount(0)
+
+	ctx := helper.WithAPIRequestResource(context.Background(), "linode_instance")
+
+	_, err = client.GetInstance(ctx, 123)
+	require.NoError(t, err)
+
+	_, err = client.CreateInstance(ctx, linodego.InstanceCreateOptions{
+		Label:    "foo",
+		RootPass: "hunter2",
+		Metadata: &linodego.InstanceMetadataOptions{UserData: "c2VjcmV0"},
+	})
+	require.NoError(t, err)
+
+	err = client.DeleteInstance(context.Background(), 123)
+	require.Error(t, err)
+
+	file, err := os.Open(logPath)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var entries []helper.AuditLogEntry
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry helper.AuditLogEntry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	require.NoError(t, scanner.Err())
+
+	// GET requests are not recorded
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, http.MethodPost, entries[0].Method)
+	assert.Equal(t, "/v4/linode/instances", entries[0].Path)
+	assert.Equal(t, http.StatusOK, entries[0].Status)
+	assert.Equal(t, "linode_instance", entries[0].ResourceType)
+	assert.False(t, entries[0].Timestamp.IsZero())
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(entries[0].Body, &body))
+	assert.Equal(t, "foo", body["label"])
+	assert.Equal(t, "REDACTED", body["root_pass"])
+	assert.Equal(t, map[string]any{"user_data": "REDACTED"}, body["metadata"])
+
+	assert.Equal(t, http.MethodDelete, entries[1].Method)
+	assert.Equal(t, "/v4/linode/instances/123", entries[1].Path)
+	assert.Equal(t, http.StatusNotFound, entries[1].Status)
+	assert.Empty(t, entries[1].ResourceType)
+	assert.Empty(t, entries[1].Body)
+}
+
+func TestNewAuditLogTransportInvalidPath(t *testing.T) {
+	_, err := helper.NewAuditLogTransport(
+		http.DefaultTransport,
+		filepath.Join(t.TempDir(), "missing", "audit.log"),
+	)
+	assert.Error(t, err)
+}
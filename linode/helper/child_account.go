@@ -0,0 +1,165 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+)
+
+// childAccountTokenRefreshWindow is how long before its expiry
+// a proxy token is replaced with a new one.
+const childAccountTokenRefreshWindow = 5 * time.Minute
+
+// ChildAccountTransport authenticates API requests using a proxy token
+// for a child account, minted using the parent account's token.
+type ChildAccountTransport struct {
+	transport http.RoundTripper
+	euuid     string
+
+	mu sync.Mutex
+
+	// parentClient is used to create proxy tokens.
+	parentClient *linodego.Client
+}
+
+// childAccountTokenKey identifies the proxy tokens of a child account
+// created using the same parent account credentials.
+type childAccountTokenKey struct {
+	euuid string
+
+	// credentials is a hash of the parent account's Authorization header.
+	credentials string
+}
+
+// childAccountTokenSource holds the proxy token of a child account shared
+// between all transports for the same EUUID and parent credentials, e.g.
+// the SDKv2 and framework providers, so only one proxy token is created
+// for them.
+type childAccountTokenSource struct {
+	euuid string
+
+	mu sync.Mutex
+
+	// parentClient is used to create proxy tokens.
+	parentClient *linodego.Client
+
+	token  string
+	expiry *time.Time
+}
+
+var (
+	childAccountTokenSourcesMu sync.Mutex
+	childAccountTokenSources   = make(map[childAccountTokenKey]*childAccountTokenSource)
+)
+
+func getChildAccountTokenSource(
+	key childAccountTokenKey,
+	parentClient *linodego.Client,
+) *childAccountTokenSource {
+	childAccountTokenSourcesMu.Lock()
+	defer childAccountTokenSourcesMu.Unlock()
+
+	if result, ok := childAccountTokenSources[key]; ok {
+		return result
+	}
+
+	result := &childAccountTokenSource{
+		euuid:        key.euuid,
+		parentClient: parentClient,
+	}
+	childAccountTokenSources[key] = result
+
+	return result
+}
+
+// NewChildAccountTransport is a RoundTripper used to make API requests
+// as the child account with the given EUUID. SetParentClient must be
+// called before any requests are made.
+func NewChildAccountTransport(transport http.RoundTripper, euuid string) *ChildAccountTransport {
+	return &ChildAccountTransport{
+		transport: transport,
+		euuid:     euuid,
+	}
+}
+
+// SetParentClient sets the client used to create proxy tokens.
+// Requests made by this client to create a proxy token are sent using
+// the parent account's token; all other requests use the proxy token.
+func (t *ChildAccountTransport) SetParentClient(client *linodego.Client) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.parentClient = client
+}
+
+// RoundTrip replaces the request's credentials with a proxy token
+// before passing it to the underlying transport.
+func (t *ChildAccountTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if t.isTokenRequest(r) {
+		return t.transport.RoundTrip(r)
+	}
+
+	t.mu.Lock()
+	parentClient := t.parentClient
+	t.mu.Unlock()
+
+	if parentClient == nil {
+		return nil, fmt.Errorf("no parent client configured for child account %s", t.euuid)
+	}
+
+	// Requests reach this transport with the parent account's credentials,
+	// so proxy tokens are only shared between clients of the same parent.
+	tokens := getChildAccountTokenSource(childAccountTokenKey{
+		euuid:       t.euuid,
+		credentials: hashCredentials(r.Header.Get("Authorization")),
+	}, parentClient)
+
+	token, err := tokens.get(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	r = r.Clone(r.Context())
+	r.Header.Set("Authorization", "Bearer "+token)
+
+	return t.transport.RoundTrip(r)
+}
+
+func (t *ChildAccountTransport) isTokenRequest(r *http.Request) bool {
+	tokenPath := fmt.Sprintf("/account/child-accounts/%s/token", url.PathEscape(t.euuid))
+
+	return r.Method == http.MethodPost && strings.HasSuffix(r.URL.EscapedPath(), tokenPath)
+}
+
+// get returns the current proxy token, creating a new one if
+// there is no token or the current token is about to expire.
+func (s *childAccountTokenSource) get(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" &&
+		(s.expiry == nil || time.Until(*s.expiry) > childAccountTokenRefreshWindow) {
+		return s.token, nil
+	}
+
+	tflog.Debug(ctx, "Creating proxy token for child account", map[string]any{
+		"child_account_euuid": s.euuid,
+	})
+
+	token, err := s.parentClient.CreateChildAccountToken(ctx, s.euuid)
+	if err != nil {
+		return "", fmt.Errorf("failed to create proxy token for child account %s: %w", s.euuid, err)
+	}
+
+	s.token = token.Token
+	s.expiry = token.Expiry
+
+	return s.token, nil
+}
@@ -0,0 +1,174 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func TestChildAccountTransport(t *testing.T) {
+	var tokensCreated int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/account/child-accounts/child-euuid/token":
+			if auth := r.Header.Get("Authorization"); auth != "Bearer parent-token" {
+				t.Errorf("expected proxy token to be created with the parent token, got %q", auth)
+			}
+
+			count := atomic.AddInt32(&tokensCreated, 1)
+
+			// The first token expires within the refresh window
+			expiry := time.Now().UTC().Add(time.Minute)
+			if count > 1 {
+				expiry = time.Now().UTC().Add(time.Hour)
+			}
+
+			fmt.Fprintf(
+				w, `{"id": %d, "token": "proxy-token-%d", "expiry": %q}`,
+				count, count, expiry.Format("2006-01-02T15:04:05"),
+			)
+		case "/v4/account":
+			expected := fmt.Sprintf("Bearer proxy-token-%d", atomic.LoadInt32(&tokensCreated))
+			if auth := r.Header.Get("Authorization"); auth != expected {
+				t.Errorf("expected Authorization header %q, got %q", expected, auth)
+			}
+
+			fmt.Fprint(w, `{"euuid": "child-euuid"}`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := &helper.Config{
+		AccessToken:          "parent-token",
+		APIURL:               server.URL,
+		ChildAccountEUUID:    "child-euuid",
+		DisableInternalCache: true,
+	}
+
+	client, err := config.Client(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetAccount(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if tokensCreated != 2 {
+		t.Errorf("expected 2 proxy tokens to be created, got %d", tokensCreated)
+	}
+}
+
+func TestChildAccountTransport_sharedProxyToken(t *testing.T) {
+	var tokensCreated int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/account/child-accounts/shared-child-euuid/token":
+			atomic.AddInt32(&tokensCreated, 1)
+
+			fmt.Fprintf(
+				w, `{"id": 1, "token": "proxy-token", "expiry": %q}`,
+				time.Now().UTC().Add(time.Hour).Format("2006-01-02T15:04:05"),
+			)
+		case "/v4/account":
+			if auth := r.Header.Get("Authorization"); auth != "Bearer proxy-token" {
+				t.Errorf("expected the shared proxy token, got %q", auth)
+			}
+
+			fmt.Fprint(w, `{"euuid": "shared-child-euuid"}`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	// Simulates the SDKv2 and framework providers configuring
+	// their own clients for the same child account.
+	for i := 0; i < 2; i++ {
+		config := &helper.Config{
+			AccessToken:          "parent-token",
+			APIURL:               server.URL,
+			ChildAccountEUUID:    "shared-child-euuid",
+			DisableInternalCache: true,
+		}
+
+		client, err := config.Client(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := client.GetAccount(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if tokensCreated != 1 {
+		t.Errorf("expected 1 proxy token to be created, got %d", tokensCreated)
+	}
+}
+
+func TestChildAccountTransport_proxyTokenPerParent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/account/child-accounts/parents-child-euuid/token":
+			// Each proxy token is named after the parent token creating it
+			fmt.Fprintf(
+				w, `{"id": 1, "token": "proxy-%s", "expiry": %q}`,
+				strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "),
+				time.Now().UTC().Add(time.Hour).Format("2006-01-02T15:04:05"),
+			)
+		case "/v4/account":
+			fmt.Fprintf(w, `{"email": %q}`, r.Header.Get("Authorization"))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	for _, parentToken := range []string{"parent-a", "parent-b"} {
+		config := &helper.Config{
+			AccessToken:          parentToken,
+			APIURL:               server.URL,
+			ChildAccountEUUID:    "parents-child-euuid",
+			DisableInternalCache: true,
+		}
+
+		client, err := config.Client(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		account, err := client.GetAccount(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if expected := "Bearer proxy-" + parentToken; account.Email != expected {
+			t.Errorf("expected Authorization header %q, got %q", expected, account.Email)
+		}
+	}
+}
@@ -23,6 +23,38 @@ const DefaultLinodeURL = "https://api.linode.com"
 type ProviderMeta struct {
 	Client linodego.Client
 	Config *Config
+
+	// EventWatcher is the provider-wide watcher of account events,
+	// may be nil if the metadata was not created by the provider.
+	EventWatcher *EventWatcher
+
+	// LinodeMutex serializes operations mutating the same Linode,
+	// may be nil if the metadata was not created by the provider.
+	LinodeMutex *KeyedMutex
+}
+
+// NewEventWaiter returns an EventWaiter for the next event matching the given
+// filter, using the provider-wide EventWatcher if one is configured.
+func (m *ProviderMeta) NewEventWaiter(ctx context.Context, filter EventFilter) (EventWaiter, error) {
+	return NewEventWaiter(ctx, m.EventWatcher, &m.Client, filter)
+}
+
+// LockLinode acquires the lock of the Linode with the given ID.
+// The returned function must be called to release the lock.
+func (m *ProviderMeta) LockLinode(ctx context.Context, linodeID int) (func(), error) {
+	return lockLinode(ctx, m.LinodeMutex, linodeID)
+}
+
+func lockLinode(ctx context.Context, mutex *KeyedMutex, linodeID int) (func(), error) {
+	if mutex == nil {
+		return func() {}, nil
+	}
+
+	tflog.Debug(ctx, "Waiting for Linode lock", map[string]any{
+		"linode_id": linodeID,
+	})
+
+	return mutex.Lock(ctx, linodeID)
 }
 
 // Config represents the Linode provider configuration.
@@ -32,6 +64,11 @@ type Config struct {
 	APIVersion  string
 	UAPrefix    string
 
+	TokenFile    string
+	TokenCommand []string
+
+	ChildAccountEUUID string
+
 	ConfigPath    string
 	ConfigProfile string
 
@@ -47,23 +84,74 @@ type Config struct {
 	LKEEventPollMilliseconds     int
 	LKENodeReadyPollMilliseconds int
 
+	MaxConcurrentRequests int
+	RequestsPerSecond     float64
+
+	RetryRules []RetryRule
+
+	AuditLogPath string
+	ReadOnly     bool
+
+	DefaultTags []string
+	IgnoreTags  []string
+
 	ObjAccessKey         string
 	ObjSecretKey         string
 	ObjUseTempKeys       bool
 	ObjBucketForceDelete bool
 }
 
-// Client returns a fully initialized Linode client.
-func (c *Config) Client(ctx context.Context) (*linodego.Client, error) {
-	loggingTransport := NewAPILoggerTransport(
+// NewAPITransport returns the transport used by Linode API clients.
+func NewAPITransport(maxConcurrentRequests int, requestsPerSecond float64) (http.RoundTripper, error) {
+	recorderTransport, err := WrapRecorderTransport(
+		NewRateLimitTransport(http.DefaultTransport, maxConcurrentRequests, requestsPerSecond),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure API recorder: %w", err)
+	}
+
+	return NewAPILoggerTransport(
 		logging.NewSubsystemLoggingHTTPTransport(
 			APILoggerSubsystem,
-			http.DefaultTransport,
+			recorderTransport,
 		),
-	)
+	), nil
+}
+
+// Client returns a fully initialized Linode client.
+func (c *Config) Client(ctx context.Context) (*linodego.Client, error) {
+	transport, err := NewAPITransport(c.MaxConcurrentRequests, c.RequestsPerSecond)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.ReadOnly {
+		transport = NewReadOnlyTransport(transport)
+	}
+
+	// The audit log wraps the read-only transport
+	// so refused requests are also recorded.
+	if c.AuditLogPath != "" {
+		transport, err = NewAuditLogTransport(transport, c.AuditLogPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var childAccountTransport *ChildAccountTransport
+	if c.ChildAccountEUUID != "" {
+		childAccountTransport = NewChildAccountTransport(transport, c.ChildAccountEUUID)
+		transport = childAccountTransport
+	}
+
+	// The token command transport must wrap the child account transport
+	// so proxy tokens are created using the token printed by the command.
+	if len(c.TokenCommand) > 0 {
+		transport = NewTokenCommandTransport(transport, c.TokenCommand)
+	}
 
 	oauth2Client := &http.Client{
-		Transport: loggingTransport,
+		Transport: transport,
 	}
 
 	client := linodego.NewClient(oauth2Client)
@@ -87,7 +175,16 @@ func (c *Config) Client(ctx context.Context) (*linodego.Client, error) {
 	}
 
 	// Overrides
-	if c.AccessToken != "" {
+	switch {
+	case c.TokenFile != "":
+		token, err := ReadTokenFile(c.TokenFile)
+		if err != nil {
+			return nil, err
+		}
+		client.SetToken(token)
+	case len(c.TokenCommand) > 0:
+		// The token is set by the token command transport
+	case c.AccessToken != "":
 		client.SetToken(c.AccessToken)
 	}
 
@@ -120,10 +217,18 @@ func (c *Config) Client(ctx context.Context) (*linodego.Client, error) {
 	client.SetUserAgent(userAgent)
 	ApplyAllRetryConditions(&client)
 
+	if err := ApplyRetryRules(&client, c.RetryRules); err != nil {
+		return nil, err
+	}
+
 	// We always want to disable resty debugging in favor
 	// of Terraform transport debugging.
 	client.SetDebug(false)
 
+	if childAccountTransport != nil {
+		childAccountTransport.SetParentClient(&client)
+	}
+
 	return &client, nil
 }
 
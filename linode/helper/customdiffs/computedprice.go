@@ -0,0 +1,52 @@
+package customdiffs
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+// ComputedPrice sets the planned `monthly_price` and `hourly_price` attributes
+// of a new resource, or a resource with changes to any of the given attributes,
+// to the price returned by the given function. The price is planned as unknown
+// if the function returns nil or fails.
+func ComputedPrice(
+	getPrice func(ctx context.Context, diff *schema.ResourceDiff, client *linodego.Client) (*helper.Price, error),
+	attributes ...string,
+) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if diff.Id() != "" && !diff.HasChanges(attributes...) {
+			return nil
+		}
+
+		var price *helper.Price
+
+		if providerMeta, ok := meta.(*helper.ProviderMeta); ok {
+			var err error
+
+			price, err = getPrice(ctx, diff, &providerMeta.Client)
+			if err != nil {
+				tflog.Warn(ctx, "Failed to get planned price", map[string]any{
+					"error": err.Error(),
+				})
+			}
+		}
+
+		if price == nil {
+			if err := diff.SetNewComputed("monthly_price"); err != nil {
+				return err
+			}
+
+			return diff.SetNewComputed("hourly_price")
+		}
+
+		if err := diff.SetNew("monthly_price", price.Monthly); err != nil {
+			return err
+		}
+
+		return diff.SetNew("hourly_price", price.Hourly)
+	}
+}
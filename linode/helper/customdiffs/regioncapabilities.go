@@ -0,0 +1,36 @@
+package customdiffs
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+// RegionCapabilities validates that the planned region of a resource supports
+// the capabilities returned by the given function. Existing resources are only
+// validated if the region or any of the given attributes has changes.
+func RegionCapabilities(
+	requiredCapabilities func(diff *schema.ResourceDiff) []string,
+	attributes ...string,
+) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		providerMeta, ok := meta.(*helper.ProviderMeta)
+		if !ok || !diff.NewValueKnown("region") {
+			return nil
+		}
+
+		if diff.Id() != "" && !diff.HasChanges(append([]string{"region"}, attributes...)...) {
+			return nil
+		}
+
+		region := diff.Get("region").(string)
+		if region == "" {
+			return nil
+		}
+
+		return helper.CheckRegionCapabilities(
+			ctx, &providerMeta.Client, region, requiredCapabilities(diff)...,
+		)
+	}
+}
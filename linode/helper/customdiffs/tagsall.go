@@ -0,0 +1,35 @@
+package customdiffs
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+// TagsAll computes the planned tags_all attribute of a resource from its
+// tags attribute and the provider-level default and ignored tags.
+//
+// NOTE: The tags_all field must be marked as computed.
+func TagsAll() schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown("tags") {
+			return diff.SetNewComputed("tags_all")
+		}
+
+		var providerTags helper.ProviderTags
+		if providerMeta, ok := meta.(*helper.ProviderMeta); ok {
+			providerTags = providerMeta.Config.GetProviderTags()
+		}
+
+		planned := providerTags.PlanTagsAll(helper.ExpandStringSet(diff.Get("tags").(*schema.Set)))
+		current := helper.ExpandStringSet(diff.Get("tags_all").(*schema.Set))
+
+		// Avoid planning a new resource's tags_all as unknown
+		if diff.Id() != "" && helper.CompareTags(current, planned) {
+			return nil
+		}
+
+		return diff.SetNew("tags_all", planned)
+	}
+}
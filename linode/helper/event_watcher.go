@@ -0,0 +1,429 @@
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/linode/linodego"
+)
+
+const (
+	// eventWatcherClockSkew is subtracted from the local time when listing
+	// the events created since a subscription was made, tolerating clock
+	// differences between the provider and the API.
+	eventWatcherClockSkew = time.Minute
+
+	// eventWatcherPollTimeout limits the duration of a single background poll.
+	eventWatcherPollTimeout = time.Minute
+
+	// eventWatcherPageSize is the maximum page size supported by the API,
+	// minimizing the number of requests made for each poll.
+	eventWatcherPageSize = 500
+
+	eventCreatedTimeFormat = "2006-01-02T15:04:05"
+)
+
+// EventWaiter waits for an event to finish. It is implemented by both
+// EventSubscription and linodego.EventPoller, allowing call sites to
+// adopt the shared EventWatcher incrementally.
+type EventWaiter interface {
+	WaitForFinished(ctx context.Context, timeoutSeconds int) (*linodego.Event, error)
+}
+
+var (
+	_ EventWaiter = &EventSubscription{}
+	_ EventWaiter = &linodego.EventPoller{}
+)
+
+// EventFilter selects the events an EventSubscription waits for.
+type EventFilter struct {
+	EntityType linodego.EntityType
+	EntityID   int
+	Action     linodego.EventAction
+
+	// The ID of the secondary entity of the event, or zero to match any event.
+	SecondaryEntityID int
+}
+
+func (f EventFilter) matches(event linodego.Event) bool {
+	if event.Action != f.Action || event.Entity == nil || event.Entity.Type != f.EntityType {
+		return false
+	}
+
+	if !eventEntityIDEquals(event.Entity.ID, f.EntityID) {
+		return false
+	}
+
+	if f.SecondaryEntityID != 0 {
+		return event.SecondaryEntity != nil && eventEntityIDEquals(event.SecondaryEntity.ID, f.SecondaryEntityID)
+	}
+
+	return true
+}
+
+// EventWatcher polls the events of the account once per interval and
+// dispatches them to subscriptions waiting for events of a specific
+// entity and action, so concurrent waits share a single event list
+// request rather than each polling on its own.
+type EventWatcher struct {
+	client   *linodego.Client
+	interval time.Duration
+
+	mu            sync.Mutex
+	subscriptions map[*EventSubscription]struct{}
+	running       bool
+
+	// Subscriptions waiting for the next poll to ignore existing events
+	pending map[*EventSubscription]struct{}
+
+	// Wakes the polling goroutine when subscriptions are pending
+	wake chan struct{}
+
+	// When the latest successful poll was started
+	lastPoll time.Time
+}
+
+// NewEventWatcher returns a new EventWatcher polling the events of the
+// account of the given client using the poll delay of the client.
+func NewEventWatcher(client *linodego.Client) *EventWatcher {
+	interval := client.GetPollDelay()
+	if interval <= 0 {
+		interval = linodego.APISecondsPerPoll * time.Second
+	}
+
+	return &EventWatcher{
+		client:        client,
+		interval:      interval,
+		subscriptions: make(map[*EventSubscription]struct{}),
+		pending:       make(map[*EventSubscription]struct{}),
+		wake:          make(chan struct{}, 1),
+	}
+}
+
+// NewEventWaiter returns an EventWaiter for the next event matching the given
+// filter, using the given watcher if it is not nil and otherwise falling back
+// to a dedicated linodego event poller.
+func NewEventWaiter(
+	ctx context.Context,
+	watcher *EventWatcher,
+	client *linodego.Client,
+	filter EventFilter,
+) (EventWaiter, error) {
+	if watcher != nil {
+		return watcher.Subscribe(ctx, filter)
+	}
+
+	if filter.SecondaryEntityID != 0 {
+		return client.NewEventPollerWithSecondary(
+			ctx, filter.EntityID, filter.EntityType, filter.SecondaryEntityID, filter.Action,
+		)
+	}
+
+	return client.NewEventPoller(ctx, filter.EntityID, filter.EntityType, filter.Action)
+}
+
+// Subscribe returns a subscription to the next event matching the given filter.
+// This should be called before the event is triggered, as all matching events
+// that exist when the subscription is made are ignored.
+//
+// Existing events are listed by the next poll of the watcher, which is
+// started immediately and shared by all concurrent calls to Subscribe.
+//
+// The subscription is closed once the given context is done.
+func (w *EventWatcher) Subscribe(ctx context.Context, filter EventFilter) (*EventSubscription, error) {
+	sub := &EventSubscription{
+		watcher: w,
+		filter:  filter,
+		since:   time.Now().UTC().Add(-eventWatcherClockSkew),
+		ignored: make(map[int]bool),
+		ready:   make(chan error, 1),
+		done:    make(chan struct{}),
+	}
+
+	w.mu.Lock()
+	w.pending[sub] = struct{}{}
+
+	if !w.running {
+		w.running = true
+		go w.run()
+	}
+	w.mu.Unlock()
+
+	select {
+	case w.wake <- struct{}{}:
+	default:
+		// A poll is already requested and will include this subscription
+	}
+
+	select {
+	case err := <-sub.ready:
+		if err != nil {
+			return nil, fmt.Errorf("failed to list events: %w", err)
+		}
+	case <-ctx.Done():
+		sub.Close()
+		return nil, fmt.Errorf("failed to list events: %w", ctx.Err())
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	sub.stop = context.AfterFunc(ctx, sub.Close)
+
+	return sub, nil
+}
+
+// poll lists the events created since the previous poll, or the creation of
+// the oldest pending subscription, along with the events still in progress
+// matched by subscriptions. The events are dispatched to all subscriptions,
+// and pending subscriptions start ignoring the events that already exist.
+func (w *EventWatcher) poll(ctx context.Context) {
+	w.mu.Lock()
+
+	pending := w.pending
+	w.pending = make(map[*EventSubscription]struct{})
+
+	since := w.lastPoll.UTC().Add(-eventWatcherClockSkew)
+
+	for sub := range pending {
+		if w.lastPoll.IsZero() || sub.since.Before(since) {
+			since = sub.since
+		}
+	}
+
+	// Events matched by subscriptions may have been created before the
+	// previous poll, so they are listed by ID until they are finished.
+	conditions := []any{
+		map[string]any{
+			"created": map[string]any{
+				"+gte": since.Format(eventCreatedTimeFormat),
+			},
+		},
+	}
+
+	for sub := range w.subscriptions {
+		if sub.matched != 0 {
+			conditions = append(conditions, map[string]any{"id": sub.matched})
+		}
+	}
+
+	w.mu.Unlock()
+
+	started := time.Now()
+
+	events, err := w.listEvents(ctx, conditions)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if err != nil {
+		for sub := range pending {
+			sub.ready <- err
+		}
+
+		if len(w.subscriptions) > 0 {
+			log.Printf("[WARN] Failed to poll account events: %s", err)
+		}
+
+		return
+	}
+
+	w.lastPoll = started
+
+	for sub := range w.subscriptions {
+		sub.dispatch(events)
+	}
+
+	for sub := range pending {
+		// Events of the same entity type and action that are already known are
+		// ignored regardless of the entity ID, since it may not be known yet.
+		for _, event := range events {
+			if event.Action == sub.filter.Action && event.Entity != nil && event.Entity.Type == sub.filter.EntityType {
+				sub.ignored[event.ID] = true
+			}
+		}
+
+		if !sub.closed {
+			w.subscriptions[sub] = struct{}{}
+		}
+
+		sub.ready <- nil
+	}
+}
+
+// listEvents lists the events matching any of the given filter conditions.
+func (w *EventWatcher) listEvents(ctx context.Context, conditions []any) ([]linodego.Event, error) {
+	filter, err := json.Marshal(map[string]any{
+		"+or":       conditions,
+		"+order_by": "created",
+		"+order":    "desc",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return w.client.ListEvents(ctx, &linodego.ListOptions{
+		PageSize: eventWatcherPageSize,
+		Filter:   string(filter),
+	})
+}
+
+// run polls the events of the account once per interval, and as soon as
+// subscriptions are pending, until there are no remaining subscriptions.
+func (w *EventWatcher) run() {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-w.wake:
+		}
+
+		w.mu.Lock()
+		if len(w.subscriptions) == 0 && len(w.pending) == 0 {
+			w.running = false
+			w.mu.Unlock()
+			return
+		}
+		w.mu.Unlock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), eventWatcherPollTimeout)
+		w.poll(ctx)
+		cancel()
+	}
+}
+
+// EventSubscription is a subscription to the next event matching a filter.
+type EventSubscription struct {
+	watcher *EventWatcher
+	filter  EventFilter
+	since   time.Time
+
+	// The IDs of events known before the subscription was made
+	ignored map[int]bool
+
+	// The ID of the event matched by the subscription, if any
+	matched int
+
+	// Receives the result of the poll listing the existing events
+	ready chan error
+
+	done   chan struct{}
+	event  *linodego.Event
+	err    error
+	closed bool
+
+	stop func() bool
+}
+
+// SetEntityID sets the ID of the entity to wait for an event of.
+// This is useful for create events, where the ID of the entity
+// is not known until after the subscription is made.
+func (s *EventSubscription) SetEntityID(id int) {
+	s.watcher.mu.Lock()
+	defer s.watcher.mu.Unlock()
+
+	s.filter.EntityID = id
+}
+
+// WaitForFinished waits for the event to be finished and closes the subscription.
+func (s *EventSubscription) WaitForFinished(ctx context.Context, timeoutSeconds int) (*linodego.Event, error) {
+	defer s.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	select {
+	case <-s.done:
+		return s.event, s.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("failed to wait for event finished: %w", ctx.Err())
+	}
+}
+
+// Close stops the subscription from receiving events.
+// It is safe to call Close multiple times.
+func (s *EventSubscription) Close() {
+	s.watcher.mu.Lock()
+	defer s.watcher.mu.Unlock()
+
+	s.close()
+}
+
+// close removes the subscription from the watcher.
+// The caller must hold the watcher's lock.
+func (s *EventSubscription) close() {
+	if s.closed {
+		return
+	}
+
+	s.closed = true
+	delete(s.watcher.subscriptions, s)
+	delete(s.watcher.pending, s)
+
+	if s.stop != nil {
+		s.stop()
+	}
+}
+
+// dispatch matches the given events, ordered from newest to oldest, against
+// the subscription. The caller must hold the watcher's lock.
+func (s *EventSubscription) dispatch(events []linodego.Event) {
+	if s.matched == 0 {
+		// Match the oldest unknown event as the first one following the subscription
+		for i := len(events) - 1; i >= 0; i-- {
+			if !s.ignored[events[i].ID] && s.filter.matches(events[i]) {
+				s.matched = events[i].ID
+				break
+			}
+		}
+
+		if s.matched == 0 {
+			return
+		}
+	}
+
+	for _, event := range events {
+		if event.ID != s.matched {
+			continue
+		}
+
+		switch event.Status {
+		case linodego.EventFinished:
+			s.finish(&event, nil)
+		case linodego.EventFailed:
+			s.finish(nil, fmt.Errorf("event %d has failed", event.ID))
+		case linodego.EventScheduled, linodego.EventStarted, linodego.EventNotification:
+			// The event is still in progress
+		}
+
+		return
+	}
+}
+
+func (s *EventSubscription) finish(event *linodego.Event, err error) {
+	s.event, s.err = event, err
+	close(s.done)
+	s.close()
+}
+
+// eventEntityIDEquals returns whether the given event entity ID,
+// which may be decoded as a float or string, equals the given ID.
+func eventEntityIDEquals(entityID any, id int) bool {
+	switch v := entityID.(type) {
+	case float64:
+		return int(v) == id
+	case int:
+		return v == id
+	case string:
+		return v == strconv.Itoa(id)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,253 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/fakeapi"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newEventWatcherTestInstance(ctx context.Context, t *testing.T, client *linodego.Client) *linodego.Instance {
+	t.Helper()
+
+	booted := false
+
+	instance, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{
+		Region: "us-east",
+		Type:   "g6-nanode-1",
+		Label:  "event-watcher",
+		Booted: &booted,
+	})
+	require.NoError(t, err)
+
+	return instance
+}
+
+func TestEventWatcher(t *testing.T) {
+	ctx := context.Background()
+
+	server := fakeapi.New()
+	t.Cleanup(server.Close)
+
+	client, err := server.Config().Client(ctx)
+	require.NoError(t, err)
+
+	instance := newEventWatcherTestInstance(ctx, t, client)
+
+	// Events created before the subscription are not matched
+	require.NoError(t, client.ShutdownInstance(ctx, instance.ID))
+
+	watcher := helper.NewEventWatcher(client)
+
+	sub, err := watcher.Subscribe(ctx, helper.EventFilter{
+		EntityType: linodego.EntityLinode,
+		EntityID:   instance.ID,
+		Action:     linodego.ActionLinodeShutdown,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, client.ShutdownInstance(ctx, instance.ID))
+
+	event, err := sub.WaitForFinished(ctx, 10)
+	require.NoError(t, err)
+	assert.EqualValues(t, server.Events()[0]["id"], event.ID)
+	assert.Equal(t, linodego.EventFinished, event.Status)
+
+	// Subscriptions without a matching event time out
+	sub, err = watcher.Subscribe(ctx, helper.EventFilter{
+		EntityType: linodego.EntityLinode,
+		EntityID:   instance.ID,
+		Action:     linodego.ActionLinodeShutdown,
+	})
+	require.NoError(t, err)
+
+	_, err = sub.WaitForFinished(ctx, 1)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestEventWatcherSetEntityID(t *testing.T) {
+	ctx := context.Background()
+
+	server := fakeapi.New()
+	t.Cleanup(server.Close)
+
+	client, err := server.Config().Client(ctx)
+	require.NoError(t, err)
+
+	newEventWatcherTestInstance(ctx, t, client)
+
+	sub, err := helper.NewEventWatcher(client).Subscribe(ctx, helper.EventFilter{
+		EntityType: linodego.EntityLinode,
+		Action:     linodego.ActionLinodeCreate,
+	})
+	require.NoError(t, err)
+
+	instance := newEventWatcherTestInstance(ctx, t, client)
+	sub.SetEntityID(instance.ID)
+
+	event, err := sub.WaitForFinished(ctx, 10)
+	require.NoError(t, err)
+	assert.EqualValues(t, server.Events()[0]["id"], event.ID)
+}
+
+func TestEventWatcherSharesPolls(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := fakeapi.New()
+	t.Cleanup(server.Close)
+
+	client, err := server.Config().Client(ctx)
+	require.NoError(t, err)
+
+	first := newEventWatcherTestInstance(ctx, t, client)
+	second := newEventWatcherTestInstance(ctx, t, client)
+
+	client.SetPollDelay(500 * time.Millisecond)
+	watcher := helper.NewEventWatcher(client)
+
+	// Each subscription polls once to ignore existing events
+	for _, instance := range []*linodego.Instance{first, second} {
+		_, err := watcher.Subscribe(ctx, helper.EventFilter{
+			EntityType: linodego.EntityLinode,
+			EntityID:   instance.ID,
+			Action:     linodego.ActionLinodeShutdown,
+		})
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 2, server.CountRequests(http.MethodGet, "account/events"))
+
+	// Background polls are shared by all subscriptions
+	time.Sleep(750 * time.Millisecond)
+
+	assert.Equal(t, 3, server.CountRequests(http.MethodGet, "account/events"))
+}
+
+func TestEventWatcherBatchesSubscriptions(t *testing.T) {
+	ctx := context.Background()
+
+	var requests atomic.Int32
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first poll is held until all other subscriptions are pending
+		if requests.Add(1) == 1 {
+			<-release
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [], "page": 1, "pages": 1, "results": 0}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := linodego.NewClient(http.DefaultClient)
+	client.SetBaseURL(server.URL)
+
+	watcher := helper.NewEventWatcher(&client)
+
+	subscribe := func() {
+		_, err := watcher.Subscribe(ctx, helper.EventFilter{
+			EntityType: linodego.EntityLinode,
+			EntityID:   1,
+			Action:     linodego.ActionLinodeShutdown,
+		})
+		assert.NoError(t, err)
+	}
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		subscribe()
+	}()
+
+	require.Eventually(t, func() bool {
+		return requests.Load() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			subscribe()
+		}()
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	// Subscriptions made during a poll share the following poll
+	assert.EqualValues(t, 2, requests.Load())
+}
+
+func TestEventWatcherIgnoresEventsBeforeSubscription(t *testing.T) {
+	ctx := context.Background()
+
+	server := fakeapi.New()
+	t.Cleanup(server.Close)
+
+	client, err := server.Config().Client(ctx)
+	require.NoError(t, err)
+
+	instance := newEventWatcherTestInstance(ctx, t, client)
+
+	client.SetPollDelay(200 * time.Millisecond)
+	watcher := helper.NewEventWatcher(client)
+
+	filter := helper.EventFilter{
+		EntityType: linodego.EntityLinode,
+		EntityID:   instance.ID,
+		Action:     linodego.ActionLinodeShutdown,
+	}
+
+	_, err = watcher.Subscribe(ctx, filter)
+	require.NoError(t, err)
+
+	// A matching event created after the previous poll but before
+	// the subscription must not be matched by the subscription
+	require.NoError(t, client.ShutdownInstance(ctx, instance.ID))
+
+	sub, err := watcher.Subscribe(ctx, filter)
+	require.NoError(t, err)
+
+	_, err = sub.WaitForFinished(ctx, 1)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestNewEventWaiter(t *testing.T) {
+	ctx := context.Background()
+
+	server := fakeapi.New()
+	t.Cleanup(server.Close)
+
+	client, err := server.Config().Client(ctx)
+	require.NoError(t, err)
+
+	filter := helper.EventFilter{
+		EntityType: linodego.EntityLinode,
+		EntityID:   1,
+		Action:     linodego.ActionLinodeBoot,
+	}
+
+	waiter, err := helper.NewEventWaiter(ctx, nil, client, filter)
+	require.NoError(t, err)
+	assert.IsType(t, &linodego.EventPoller{}, waiter)
+
+	waiter, err = helper.NewEventWaiter(ctx, helper.NewEventWatcher(client), client, filter)
+	require.NoError(t, err)
+	assert.IsType(t, &helper.EventSubscription{}, waiter)
+}
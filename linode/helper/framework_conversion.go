@@ -51,6 +51,26 @@ func StringSliceToFrameworkValueSlice(val []string) []attr.Value {
 	return GenericSliceToFramework(val, GetBaseSafeFwValueConverter(types.StringValue))
 }
 
+// StringSliceToFrameworkSet converts the given string slice
+// into a framework set of strings, returning a null set for nil slices.
+func StringSliceToFrameworkSet(val []string) types.Set {
+	if val == nil {
+		return types.SetNull(types.StringType)
+	}
+
+	return types.SetValueMust(types.StringType, StringSliceToFrameworkValueSlice(val))
+}
+
+// StringSliceToFrameworkList converts the given string slice
+// into a framework list of strings, returning a null list for nil slices.
+func StringSliceToFrameworkList(val []string) types.List {
+	if val == nil {
+		return types.ListNull(types.StringType)
+	}
+
+	return types.ListValueMust(types.StringType, StringSliceToFrameworkValueSlice(val))
+}
+
 // IntSliceToFrameworkValueSlice converts the given string slice
 // into a framework-compatible slice of attr.Value.
 func IntSliceToFrameworkValueSlice(val []int) []attr.Value {
@@ -0,0 +1,171 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+)
+
+// privateRotateDatabaseID is the private data key the ID of a database
+// to reset the credentials of on close is stored under.
+const privateRotateDatabaseID = "rotate_database_id"
+
+// DatabaseCredentialsEngine contains the engine-specific API calls
+// of a database credentials ephemeral resource.
+type DatabaseCredentialsEngine struct {
+	// Label is the name of the engine used in diagnostics, e.g. `MySQL`.
+	Label string
+
+	// GetHosts returns the hosts and port of the database.
+	GetHosts func(ctx context.Context, client *linodego.Client, id int) (linodego.DatabaseHost, int, error)
+
+	// GetCACert returns the CA certificate of the database.
+	GetCACert func(ctx context.Context, client *linodego.Client, id int) ([]byte, error)
+
+	// GetCredentials returns the root username and password of the database.
+	GetCredentials func(ctx context.Context, client *linodego.Client, id int) (string, string, error)
+
+	// ResetCredentials resets the root password of the database.
+	ResetCredentials func(ctx context.Context, client *linodego.Client, id int) error
+}
+
+// DatabaseCredentialsModel describes the Terraform ephemeral resource data model
+// to match the database credentials ephemeral resource schemas.
+type DatabaseCredentialsModel struct {
+	DatabaseID    types.Int64  `tfsdk:"database_id"`
+	RotateOnClose types.Bool   `tfsdk:"rotate_on_close"`
+	RootUsername  types.String `tfsdk:"root_username"`
+	RootPassword  types.String `tfsdk:"root_password"`
+	HostPrimary   types.String `tfsdk:"host_primary"`
+	HostSecondary types.String `tfsdk:"host_secondary"`
+	Port          types.Int64  `tfsdk:"port"`
+	CACert        types.String `tfsdk:"ca_cert"`
+}
+
+func (data *DatabaseCredentialsModel) FlattenHosts(hosts linodego.DatabaseHost, port int) {
+	data.HostPrimary = types.StringValue(hosts.Primary)
+	data.HostSecondary = types.StringValue(hosts.Secondary)
+	data.Port = types.Int64Value(int64(port))
+}
+
+func (data *DatabaseCredentialsModel) FlattenCACert(caCert []byte) {
+	data.CACert = types.StringValue(string(caCert))
+}
+
+func (data *DatabaseCredentialsModel) FlattenCredentials(username, password string) {
+	data.RootUsername = types.StringValue(username)
+	data.RootPassword = types.StringValue(password)
+}
+
+// NewDatabaseCredentialsEphemeralResource returns an ephemeral resource exposing
+// the root credentials of a managed database of the given engine, optionally
+// resetting them once Terraform is done using them.
+func NewDatabaseCredentialsEphemeralResource(
+	cfg BaseEphemeralResourceConfig,
+	engine DatabaseCredentialsEngine,
+) ephemeral.EphemeralResource {
+	return &DatabaseCredentialsEphemeralResource{
+		BaseEphemeralResource: NewBaseEphemeralResource(cfg),
+		Engine:                engine,
+	}
+}
+
+type DatabaseCredentialsEphemeralResource struct {
+	BaseEphemeralResource
+
+	Engine DatabaseCredentialsEngine
+}
+
+func (r *DatabaseCredentialsEphemeralResource) Open(
+	ctx context.Context,
+	req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse,
+) {
+	tflog.Debug(ctx, "Open "+r.Config.Name)
+
+	client := r.Meta.Client
+
+	var data DatabaseCredentialsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := FrameworkSafeInt64ToInt(data.DatabaseID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "database_id", id)
+
+	hosts, port, err := r.Engine.GetHosts(ctx, client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get %s database: ", r.Engine.Label), err.Error(),
+		)
+		return
+	}
+
+	caCert, err := r.Engine.GetCACert(ctx, client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get cert for the specified %s database: ", r.Engine.Label), err.Error(),
+		)
+		return
+	}
+
+	username, password, err := r.Engine.GetCredentials(ctx, client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get credentials for %s database: ", r.Engine.Label), err.Error(),
+		)
+		return
+	}
+
+	if data.RotateOnClose.ValueBool() {
+		resp.Diagnostics.Append(
+			resp.Private.SetKey(ctx, privateRotateDatabaseID, []byte(strconv.Itoa(id)))...,
+		)
+	}
+
+	data.FlattenHosts(hosts, port)
+	data.FlattenCACert(caCert)
+	data.FlattenCredentials(username, password)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *DatabaseCredentialsEphemeralResource) Close(
+	ctx context.Context,
+	req ephemeral.CloseRequest,
+	resp *ephemeral.CloseResponse,
+) {
+	tflog.Debug(ctx, "Close "+r.Config.Name)
+
+	rawID, diags := req.Private.GetKey(ctx, privateRotateDatabaseID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || rawID == nil {
+		return
+	}
+
+	id, err := strconv.Atoi(string(rawID))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to parse %s database ID", r.Engine.Label), err.Error(),
+		)
+		return
+	}
+
+	if err := r.Engine.ResetCredentials(ctx, r.Meta.Client, id); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to reset credentials for %s database %d", r.Engine.Label, id),
+			err.Error(),
+		)
+	}
+}
@@ -0,0 +1,30 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabaseCredentialsModel_flatten(t *testing.T) {
+	data := &helper.DatabaseCredentialsModel{}
+
+	data.FlattenHosts(linodego.DatabaseHost{
+		Primary:   "lin-0000-000-pgsql-primary.servers.linodedb.net",
+		Secondary: "lin-0000-000-pgsql-primary-private.servers.linodedb.net",
+	}, 5432)
+	data.FlattenCACert([]byte("-----BEGIN CERTIFICATE-----"))
+	data.FlattenCredentials("linroot", "password123")
+
+	assert.Equal(t, types.StringValue("lin-0000-000-pgsql-primary.servers.linodedb.net"), data.HostPrimary)
+	assert.Equal(t, types.StringValue("lin-0000-000-pgsql-primary-private.servers.linodedb.net"), data.HostSecondary)
+	assert.Equal(t, types.Int64Value(5432), data.Port)
+	assert.Equal(t, types.StringValue("-----BEGIN CERTIFICATE-----"), data.CACert)
+	assert.Equal(t, types.StringValue("linroot"), data.RootUsername)
+	assert.Equal(t, types.StringValue("password123"), data.RootPassword)
+}
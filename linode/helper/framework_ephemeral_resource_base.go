@@ -0,0 +1,79 @@
+package helper
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+)
+
+// NewBaseEphemeralResource returns a new instance of the BaseEphemeralResource
+// struct for cleaner initialization.
+func NewBaseEphemeralResource(cfg BaseEphemeralResourceConfig) BaseEphemeralResource {
+	return BaseEphemeralResource{
+		Config: cfg,
+	}
+}
+
+// BaseEphemeralResourceConfig contains all configurable base ephemeral resource fields.
+type BaseEphemeralResourceConfig struct {
+	Name string
+
+	// Optional
+	Schema        *schema.Schema
+	IsEarlyAccess bool
+}
+
+// BaseEphemeralResource contains various re-usable fields and methods
+// intended for use in ephemeral resource implementations by composition.
+type BaseEphemeralResource struct {
+	Config BaseEphemeralResourceConfig
+	Meta   *FrameworkProviderMeta
+}
+
+func (r *BaseEphemeralResource) Configure(
+	ctx context.Context,
+	req ephemeral.ConfigureRequest,
+	resp *ephemeral.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.Meta = GetEphemeralResourceMeta(req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.Config.IsEarlyAccess {
+		resp.Diagnostics.Append(
+			AttemptWarnEarlyAccessFramework(r.Meta.Config)...,
+		)
+	}
+}
+
+func (r *BaseEphemeralResource) Metadata(
+	ctx context.Context,
+	req ephemeral.MetadataRequest,
+	resp *ephemeral.MetadataResponse,
+) {
+	resp.TypeName = r.Config.Name
+}
+
+func (r *BaseEphemeralResource) Schema(
+	ctx context.Context,
+	req ephemeral.SchemaRequest,
+	resp *ephemeral.SchemaResponse,
+) {
+	if r.Config.Schema == nil {
+		resp.Diagnostics.AddError(
+			"Missing Schema",
+			"Base ephemeral resource was not provided a schema. "+
+				"Please provide a Schema config attribute or implement, the Schema(...) function.",
+		)
+		return
+	}
+
+	resp.Schema = *r.Config.Schema
+}
@@ -1,6 +1,9 @@
 package helper
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/linodego"
 )
@@ -12,6 +15,9 @@ func GetFrameworkProviderModelFromSDKv2ProviderConfig(config *Config) *Framework
 		APIURL:                       types.StringValue(config.APIURL),
 		APIVersion:                   types.StringValue(config.APIVersion),
 		UAPrefix:                     types.StringValue(config.UAPrefix),
+		TokenFile:                    types.StringValue(config.TokenFile),
+		TokenCommand:                 StringSliceToFrameworkList(config.TokenCommand),
+		ChildAccountEUUID:            types.StringValue(config.ChildAccountEUUID),
 		ConfigPath:                   types.StringValue(config.ConfigPath),
 		ConfigProfile:                types.StringValue(config.ConfigProfile),
 		SkipInstanceReadyPoll:        types.BoolValue(config.SkipInstanceReadyPoll),
@@ -23,6 +29,13 @@ func GetFrameworkProviderModelFromSDKv2ProviderConfig(config *Config) *Framework
 		EventPollMilliseconds:        types.Int64Value(int64(config.EventPollMilliseconds)),
 		LKEEventPollMilliseconds:     types.Int64Value(int64(config.LKEEventPollMilliseconds)),
 		LKENodeReadyPollMilliseconds: types.Int64Value(int64(config.LKENodeReadyPollMilliseconds)),
+		MaxConcurrentRequests:        types.Int64Value(int64(config.MaxConcurrentRequests)),
+		RequestsPerSecond:            types.Float64Value(config.RequestsPerSecond),
+		RetryRules:                   retryRulesToFramework(config.RetryRules),
+		AuditLogPath:                 types.StringValue(config.AuditLogPath),
+		ReadOnly:                     types.BoolValue(config.ReadOnly),
+		DefaultTags:                  StringSliceToFrameworkSet(config.DefaultTags),
+		IgnoreTags:                   StringSliceToFrameworkSet(config.IgnoreTags),
 		ObjAccessKey:                 types.StringValue(config.ObjAccessKey),
 		ObjSecretKey:                 types.StringValue(config.ObjSecretKey),
 		ObjUseTempKeys:               types.BoolValue(config.ObjUseTempKeys),
@@ -36,6 +49,11 @@ type FrameworkProviderModel struct {
 	APIVersion  types.String `tfsdk:"api_version"`
 	UAPrefix    types.String `tfsdk:"ua_prefix"`
 
+	TokenFile    types.String `tfsdk:"token_file"`
+	TokenCommand types.List   `tfsdk:"token_command"`
+
+	ChildAccountEUUID types.String `tfsdk:"child_account_euuid"`
+
 	ConfigPath    types.String `tfsdk:"config_path"`
 	ConfigProfile types.String `tfsdk:"config_profile"`
 
@@ -54,13 +72,73 @@ type FrameworkProviderModel struct {
 
 	LKENodeReadyPollMilliseconds types.Int64 `tfsdk:"lke_node_ready_poll_ms"`
 
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+
+	RetryRules []FrameworkRetryRuleModel `tfsdk:"retry_rule"`
+
+	AuditLogPath types.String `tfsdk:"audit_log_path"`
+	ReadOnly     types.Bool   `tfsdk:"read_only"`
+
+	DefaultTags types.Set `tfsdk:"default_tags"`
+	IgnoreTags  types.Set `tfsdk:"ignore_tags"`
+
 	ObjAccessKey         types.String `tfsdk:"obj_access_key"`
 	ObjSecretKey         types.String `tfsdk:"obj_secret_key"`
 	ObjUseTempKeys       types.Bool   `tfsdk:"obj_use_temp_keys"`
 	ObjBucketForceDelete types.Bool   `tfsdk:"obj_bucket_force_delete"`
 }
 
+type FrameworkRetryRuleModel struct {
+	StatusCodes types.Set    `tfsdk:"status_codes"`
+	PathRegex   types.String `tfsdk:"path_regex"`
+	MaxAttempts types.Int64  `tfsdk:"max_attempts"`
+}
+
+// ToRetryRule converts the framework model into a RetryRule.
+func (m FrameworkRetryRuleModel) ToRetryRule(diags *diag.Diagnostics) RetryRule {
+	return RetryRule{
+		StatusCodes: ExpandFwInt64Set(m.StatusCodes, diags),
+		PathRegex:   m.PathRegex.ValueString(),
+		MaxAttempts: FrameworkSafeInt64ToInt(m.MaxAttempts.ValueInt64(), diags),
+	}
+}
+
+func retryRulesToFramework(rules []RetryRule) []FrameworkRetryRuleModel {
+	result := make([]FrameworkRetryRuleModel, len(rules))
+
+	for i, rule := range rules {
+		result[i] = FrameworkRetryRuleModel{
+			StatusCodes: types.SetValueMust(types.Int64Type, IntSliceToFrameworkValueSlice(rule.StatusCodes)),
+			PathRegex:   types.StringValue(rule.PathRegex),
+			MaxAttempts: types.Int64Value(int64(rule.MaxAttempts)),
+		}
+	}
+
+	return result
+}
+
 type FrameworkProviderMeta struct {
 	Client *linodego.Client
 	Config *FrameworkProviderModel
+
+	// EventWatcher is the provider-wide watcher of account events,
+	// may be nil if the metadata was not created by the provider.
+	EventWatcher *EventWatcher
+
+	// LinodeMutex serializes operations mutating the same Linode,
+	// may be nil if the metadata was not created by the provider.
+	LinodeMutex *KeyedMutex
+}
+
+// NewEventWaiter returns an EventWaiter for the next event matching the given
+// filter, using the provider-wide EventWatcher if one is configured.
+func (m *FrameworkProviderMeta) NewEventWaiter(ctx context.Context, filter EventFilter) (EventWaiter, error) {
+	return NewEventWaiter(ctx, m.EventWatcher, m.Client, filter)
+}
+
+// LockLinode acquires the lock of the Linode with the given ID.
+// The returned function must be called to release the lock.
+func (m *FrameworkProviderMeta) LockLinode(ctx context.Context, linodeID int) (func(), error) {
+	return lockLinode(ctx, m.LinodeMutex, linodeID)
 }
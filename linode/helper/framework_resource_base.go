@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -31,6 +32,9 @@ type BaseResourceConfig struct {
 	Schema        *schema.Schema
 	TimeoutOpts   *timeouts.Opts
 	IsEarlyAccess bool
+
+	// Allows the resource to be imported by label, see ImportLabelConfig.
+	ImportLabel *ImportLabelConfig
 }
 
 // BaseResource contains various re-usable fields and methods
@@ -123,15 +127,30 @@ func (r *BaseResource) ImportState(
 		return
 	}
 
+	importID := req.ID
+
+	if r.Config.ImportLabel != nil && strings.HasPrefix(importID, ImportLabelPrefix) {
+		resolvedID, err := r.Config.ImportLabel.ResolveID(ctx, r.Meta.Client, r.Config.Name, importID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Resolve Import Label",
+				err.Error(),
+			)
+			return
+		}
+
+		importID = resolvedID
+	}
+
 	// Handle type conversion
 	var err error
 	var idValue any
 
 	switch idType {
 	case types.Int64Type:
-		idValue, err = strconv.ParseInt(req.ID, 10, 64)
+		idValue, err = strconv.ParseInt(importID, 10, 64)
 	case types.StringType:
-		idValue = req.ID
+		idValue = importID
 	default:
 		err = fmt.Errorf("unsupported id attribute type: %v", idType)
 	}
@@ -3,6 +3,7 @@ package helper
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -28,3 +29,14 @@ func FrameworkAttemptRemoveResourceForEmptyID(
 
 	return true
 }
+
+// FrameworkValuesKnown returns true if none of the given values are null or unknown.
+func FrameworkValuesKnown(values ...attr.Value) bool {
+	for _, v := range values {
+		if v.IsNull() || v.IsUnknown() {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,126 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/linodego"
+)
+
+// ImportLabelPrefix is the prefix of import IDs that should be
+// resolved through the label of an entity rather than its ID.
+const ImportLabelPrefix = "label:"
+
+// ImportLabelLookupFunc returns the IDs of all entities
+// matching the given API filter.
+type ImportLabelLookupFunc func(ctx context.Context, client *linodego.Client, filter string) ([]string, error)
+
+// ImportLabelConfig allows a resource to be imported using an ID
+// in the format `label:<name>` or `label:<region>/<name>`.
+type ImportLabelConfig struct {
+	// The API field the label is matched against, defaults to `label`.
+	LabelField string
+
+	// Whether entities of this type can be filtered by region.
+	SupportsRegion bool
+
+	Lookup ImportLabelLookupFunc
+}
+
+// ParseImportLabelID parses an import ID in the format `label:<name>`
+// or `label:<region>/<name>`. The returned bool is false if the given
+// import ID does not refer to a label.
+func ParseImportLabelID(importID string) (label, region string, ok bool) {
+	value, ok := strings.CutPrefix(importID, ImportLabelPrefix)
+	if !ok {
+		return "", "", false
+	}
+
+	if r, l, found := strings.Cut(value, "/"); found {
+		return l, r, true
+	}
+
+	return value, "", true
+}
+
+// ResolveID resolves the given `label:` import ID into the ID of the only
+// entity matching it, returning an error if zero or multiple entities match.
+func (c ImportLabelConfig) ResolveID(
+	ctx context.Context,
+	client *linodego.Client,
+	entityName, importID string,
+) (string, error) {
+	label, region, ok := ParseImportLabelID(importID)
+	if !ok {
+		return "", fmt.Errorf("expected import identifier with format %q, got %q", ImportLabelPrefix+"<label>", importID)
+	}
+
+	if label == "" {
+		return "", fmt.Errorf("import identifier %q does not contain a label", importID)
+	}
+
+	if region != "" && !c.SupportsRegion {
+		return "", fmt.Errorf("%s can not be imported by region, use %q instead", entityName, ImportLabelPrefix+label)
+	}
+
+	labelField := c.LabelField
+	if labelField == "" {
+		labelField = "label"
+	}
+
+	filter := linodego.Filter{}
+	filter.AddField(linodego.Eq, labelField, label)
+
+	if region != "" {
+		filter.AddField(linodego.Eq, "region", region)
+	}
+
+	filterBytes, err := filter.MarshalJSON()
+	if err != nil {
+		return "", err
+	}
+
+	ids, err := c.Lookup(ctx, client, string(filterBytes))
+	if err != nil {
+		return "", fmt.Errorf("failed to list %s matching %q: %w", entityName, importID, err)
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no %s found matching %q", entityName, importID)
+	case 1:
+		return ids[0], nil
+	}
+
+	hint := "import it by ID instead"
+	if c.SupportsRegion && region == "" {
+		hint = fmt.Sprintf("use %q or import it by ID instead", ImportLabelPrefix+"<region>/"+label)
+	}
+
+	return "", fmt.Errorf(
+		"multiple %s found matching %q (IDs: %s), %s",
+		entityName, importID, strings.Join(ids, ", "), hint,
+	)
+}
+
+// ImportStatePassthroughLabelContext returns an SDKv2 import function that
+// passes numeric IDs through and resolves `label:` import IDs to the ID of the
+// matching entity.
+func ImportStatePassthroughLabelContext(entityName string, cfg ImportLabelConfig) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if !strings.HasPrefix(d.Id(), ImportLabelPrefix) {
+			return schema.ImportStatePassthroughContext(ctx, d, meta)
+		}
+
+		id, err := cfg.ResolveID(ctx, &meta.(*ProviderMeta).Client, entityName, d.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(id)
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
@@ -0,0 +1,91 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func TestParseImportLabelID(t *testing.T) {
+	testCases := []struct {
+		importID string
+		label    string
+		region   string
+		ok       bool
+	}{
+		{"12345", "", "", false},
+		{"label:my-instance", "my-instance", "", true},
+		{"label:us-east/my-volume", "my-volume", "us-east", true},
+		{"label:", "", "", true},
+	}
+
+	for _, tc := range testCases {
+		label, region, ok := helper.ParseImportLabelID(tc.importID)
+
+		if label != tc.label || region != tc.region || ok != tc.ok {
+			t.Errorf(
+				"ParseImportLabelID(%q) = (%q, %q, %v), expected (%q, %q, %v)",
+				tc.importID, label, region, ok, tc.label, tc.region, tc.ok,
+			)
+		}
+	}
+}
+
+func TestImportLabelConfig_ResolveID(t *testing.T) {
+	var lastFilter string
+
+	newConfig := func(supportsRegion bool, ids ...string) helper.ImportLabelConfig {
+		return helper.ImportLabelConfig{
+			SupportsRegion: supportsRegion,
+			Lookup: func(ctx context.Context, client *linodego.Client, filter string) ([]string, error) {
+				lastFilter = filter
+				return ids, nil
+			},
+		}
+	}
+
+	id, err := newConfig(true, "123").ResolveID(context.Background(), nil, "linode_volume", "label:us-east/my-volume")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if id != "123" {
+		t.Errorf("expected ID 123, got %q", id)
+	}
+
+	if lastFilter != `{"label":"my-volume","region":"us-east"}` {
+		t.Errorf("unexpected filter: %s", lastFilter)
+	}
+
+	cfg := newConfig(false, "example.com-id")
+	cfg.LabelField = "domain"
+
+	if _, err := cfg.ResolveID(context.Background(), nil, "linode_domain", "label:example.com"); err != nil {
+		t.Fatal(err)
+	}
+
+	if lastFilter != `{"domain":"example.com"}` {
+		t.Errorf("unexpected filter: %s", lastFilter)
+	}
+
+	for name, tc := range map[string]struct {
+		cfg      helper.ImportLabelConfig
+		importID string
+	}{
+		"no match":           {newConfig(true), "label:my-volume"},
+		"multiple matches":   {newConfig(true, "1", "2"), "label:my-volume"},
+		"empty label":        {newConfig(true, "1"), "label:"},
+		"unsupported region": {newConfig(false, "1"), "label:us-east/my-firewall"},
+		"not a label":        {newConfig(true, "1"), "12345"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := tc.cfg.ResolveID(context.Background(), nil, "linode_volume", tc.importID); err == nil {
+				t.Errorf("expected error for import ID %q", tc.importID)
+			}
+		})
+	}
+}
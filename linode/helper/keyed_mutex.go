@@ -0,0 +1,80 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// KeyedMutex is a set of mutual exclusion locks identified by integer keys,
+// e.g. Linode IDs. Locks are created on demand and released once unused.
+type KeyedMutex struct {
+	mu    sync.Mutex
+	locks map[int]*keyedLock
+}
+
+type keyedLock struct {
+	// semaphore holds a value while the lock is held
+	semaphore chan struct{}
+
+	// refs is the number of callers holding or waiting for the lock
+	refs int
+}
+
+// linodeMutex is shared between all providers in the process, e.g. the
+// SDKv2 and framework providers, since Linode IDs are globally unique.
+var linodeMutex = NewKeyedMutex()
+
+// NewKeyedMutex returns a new KeyedMutex.
+func NewKeyedMutex() *KeyedMutex {
+	return &KeyedMutex{
+		locks: make(map[int]*keyedLock),
+	}
+}
+
+// LinodeMutex returns the KeyedMutex used to serialize operations
+// mutating the same Linode across all resources of the provider.
+func LinodeMutex() *KeyedMutex {
+	return linodeMutex
+}
+
+// Lock waits until the lock for the given key is acquired or the context
+// is done. The returned function must be called to release the lock.
+func (m *KeyedMutex) Lock(ctx context.Context, key int) (func(), error) {
+	m.mu.Lock()
+	lock, ok := m.locks[key]
+	if !ok {
+		lock = &keyedLock{semaphore: make(chan struct{}, 1)}
+		m.locks[key] = lock
+	}
+	lock.refs++
+	m.mu.Unlock()
+
+	select {
+	case lock.semaphore <- struct{}{}:
+	case <-ctx.Done():
+		m.release(key, lock)
+		return nil, fmt.Errorf("failed to acquire lock for %d: %w", key, ctx.Err())
+	}
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			<-lock.semaphore
+			m.release(key, lock)
+		})
+	}, nil
+}
+
+// release drops a reference to the given lock,
+// removing it from the mutex once it is unused.
+func (m *KeyedMutex) release(key int, lock *keyedLock) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	lock.refs--
+	if lock.refs == 0 {
+		delete(m.locks, key)
+	}
+}
@@ -0,0 +1,112 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyedMutexSerializesKey(t *testing.T) {
+	mutex := helper.NewKeyedMutex()
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		holders int
+		maxSeen int
+	)
+
+	for range 10 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			unlock, err := mutex.Lock(context.Background(), 123)
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer unlock()
+
+			mu.Lock()
+			holders++
+			maxSeen = max(maxSeen, holders)
+			mu.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			holders--
+			mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, 1, maxSeen)
+}
+
+func TestKeyedMutexIndependentKeys(t *testing.T) {
+	mutex := helper.NewKeyedMutex()
+
+	unlock, err := mutex.Lock(context.Background(), 1)
+	require.NoError(t, err)
+	defer unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	otherUnlock, err := mutex.Lock(ctx, 2)
+	require.NoError(t, err)
+	otherUnlock()
+}
+
+func TestKeyedMutexContextDone(t *testing.T) {
+	mutex := helper.NewKeyedMutex()
+
+	unlock, err := mutex.Lock(context.Background(), 1)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = mutex.Lock(ctx, 1)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Releasing the lock more than once has no effect
+	unlock()
+	unlock()
+
+	unlock, err = mutex.Lock(context.Background(), 1)
+	require.NoError(t, err)
+	unlock()
+}
+
+func TestProviderMetaLockLinode(t *testing.T) {
+	// Metadata created outside of the provider has no mutex
+	unlock, err := (&helper.ProviderMeta{}).LockLinode(context.Background(), 1)
+	require.NoError(t, err)
+	unlock()
+
+	meta := &helper.ProviderMeta{LinodeMutex: helper.LinodeMutex()}
+	frameworkMeta := &helper.FrameworkProviderMeta{LinodeMutex: helper.LinodeMutex()}
+
+	unlock, err = meta.LockLinode(context.Background(), 1)
+	require.NoError(t, err)
+
+	// The SDKv2 and framework providers share the same locks
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = frameworkMeta.LockLinode(ctx, 1)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	unlock()
+}
@@ -2,6 +2,7 @@ package helper
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"os"
 
@@ -33,8 +34,34 @@ func NewAPILoggerTransport(transport http.RoundTripper) *APILoggerTransport {
 // RoundTrip injects the API logger subsystem into the context
 // of an API request. This allows us to configure the logger without
 // creating a new logger in each implementation.
+//
+// The request ID, status and endpoint of each response are logged with
+// the context of the request and recorded in its API request tracker, so
+// failed requests are also logged with the context of the RPC and appended
+// to its error diagnostics, see NewAPIRequestServer.
 func (t *APILoggerTransport) RoundTrip(r *http.Request) (*http.Response, error) {
-	return t.transport.RoundTrip(r.WithContext(t.createAPILoggerSubsystem(r.Context())))
+	resp, err := t.transport.RoundTrip(r.WithContext(t.createAPILoggerSubsystem(r.Context())))
+	if err != nil {
+		return resp, err
+	}
+
+	info := NewAPIRequestInfo(r, resp)
+
+	ctx := SetLogFieldBulk(r.Context(), map[string]any{
+		"request_id": info.RequestID,
+		"status":     info.Status,
+		"endpoint":   fmt.Sprintf("%s %s", info.Method, info.Endpoint),
+	})
+
+	if info.Failed() {
+		tflog.Debug(ctx, "Linode API request failed")
+	} else {
+		tflog.Trace(ctx, "Linode API request succeeded")
+	}
+
+	trackAPIRequest(ctx, info)
+
+	return resp, nil
 }
 
 // createAPILoggerSubsystem creates an API logger subsystem
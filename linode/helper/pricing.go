@@ -0,0 +1,347 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+)
+
+// The API returns prices as 32-bit floats, so computed prices
+// are rounded to avoid exposing floating point artifacts.
+const pricePrecision = 1e6
+
+const (
+	volumeTypesEndpoint       = "volumes/types"
+	nodeBalancerTypesEndpoint = "nodebalancers/types"
+	lkeTypesEndpoint          = "lke/types"
+
+	volumeTypeID            = "volume"
+	nodeBalancerTypeID      = "nodebalancer"
+	lkeHAControlPlaneTypeID = "lke-ha"
+)
+
+// Price is the hourly and monthly price of a billable entity in US dollars.
+type Price struct {
+	Hourly  float64
+	Monthly float64
+}
+
+// Add returns the sum of the two prices.
+func (p Price) Add(other Price) Price {
+	return Price{
+		Hourly:  roundPrice(p.Hourly + other.Hourly),
+		Monthly: roundPrice(p.Monthly + other.Monthly),
+	}
+}
+
+// Multiply returns the price of the given quantity of the priced entity.
+func (p Price) Multiply(quantity int) Price {
+	return Price{
+		Hourly:  roundPrice(p.Hourly * float64(quantity)),
+		Monthly: roundPrice(p.Monthly * float64(quantity)),
+	}
+}
+
+// TypePrice returns the price of a type in the given region, preferring
+// a region-specific price over the default price of the type.
+func TypePrice(
+	price *linodego.LinodePrice,
+	regionPrices []linodego.LinodeRegionPrice,
+	region string,
+) Price {
+	for _, regionPrice := range regionPrices {
+		if regionPrice.ID == region {
+			return Price{
+				Hourly:  float32ToPrice(regionPrice.Hourly),
+				Monthly: float32ToPrice(regionPrice.Monthly),
+			}
+		}
+	}
+
+	if price == nil {
+		return Price{}
+	}
+
+	return Price{
+		Hourly:  float32ToPrice(price.Hourly),
+		Monthly: float32ToPrice(price.Monthly),
+	}
+}
+
+// GetLinodeTypePrice returns the price of a Linode of the given type in the
+// given region, including the price of the Backup service if backups is true.
+func GetLinodeTypePrice(
+	ctx context.Context,
+	client *linodego.Client,
+	typeID, region string,
+	backups bool,
+) (Price, error) {
+	linodeType, err := getTypeCache(client).getLinodeType(ctx, client, typeID)
+	if err != nil {
+		return Price{}, err
+	}
+
+	result := TypePrice(linodeType.Price, linodeType.RegionPrices, region)
+
+	if backups && linodeType.Addons != nil && linodeType.Addons.Backups != nil {
+		result = result.Add(
+			TypePrice(linodeType.Addons.Backups.Price, linodeType.Addons.Backups.RegionPrices, region),
+		)
+	}
+
+	return result, nil
+}
+
+// GetVolumePrice returns the price of a Block Storage Volume
+// of the given size in GB in the given region.
+func GetVolumePrice(ctx context.Context, client *linodego.Client, region string, size int) (Price, error) {
+	price, err := getEntityTypePrice(ctx, client, volumeTypesEndpoint, volumeTypeID, region)
+	if err != nil {
+		return Price{}, err
+	}
+
+	// Volumes are priced per GB
+	return price.Multiply(size), nil
+}
+
+// GetNodeBalancerPrice returns the price of a NodeBalancer in the given region.
+func GetNodeBalancerPrice(ctx context.Context, client *linodego.Client, region string) (Price, error) {
+	return getEntityTypePrice(ctx, client, nodeBalancerTypesEndpoint, nodeBalancerTypeID, region)
+}
+
+// GetLKEHAControlPlanePrice returns the price of a high availability
+// LKE control plane in the given region.
+func GetLKEHAControlPlanePrice(ctx context.Context, client *linodego.Client, region string) (Price, error) {
+	return getEntityTypePrice(ctx, client, lkeTypesEndpoint, lkeHAControlPlaneTypeID, region)
+}
+
+// FrameworkSetPrice sets the given `monthly_price` and `hourly_price` values
+// to the result of the given function. Pricing failures are logged and
+// ignored, keeping known values and setting unknown values to null.
+func FrameworkSetPrice(
+	ctx context.Context,
+	monthly, hourly *types.Float64,
+	getPrice func() (Price, error),
+) {
+	price, err := getPrice()
+	if err != nil {
+		tflog.Warn(ctx, "Failed to get price", map[string]any{
+			"error": err.Error(),
+		})
+
+		if monthly.IsUnknown() {
+			*monthly = types.Float64Null()
+		}
+
+		if hourly.IsUnknown() {
+			*hourly = types.Float64Null()
+		}
+
+		return
+	}
+
+	*monthly = types.Float64Value(price.Monthly)
+	*hourly = types.Float64Value(price.Hourly)
+}
+
+// FrameworkModifyPlanPrice sets the planned `monthly_price` and `hourly_price`
+// attributes of a new resource, or a resource with changes to any of the given
+// top-level attributes, to the price returned by the given function.
+// The price is planned as unknown if the function returns nil or fails.
+func FrameworkModifyPlanPrice(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+	getPrice func() (*Price, error),
+	attributes ...string,
+) {
+	// Nothing to do for destroy plans
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() && !rawAttributesChanged(req.Plan.Raw, req.State.Raw, attributes...) {
+		return
+	}
+
+	monthly, hourly := types.Float64Unknown(), types.Float64Unknown()
+
+	price, err := getPrice()
+	if err != nil {
+		tflog.Warn(ctx, "Failed to get planned price", map[string]any{
+			"error": err.Error(),
+		})
+	} else if price != nil {
+		monthly, hourly = types.Float64Value(price.Monthly), types.Float64Value(price.Hourly)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("monthly_price"), monthly)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("hourly_price"), hourly)...)
+}
+
+// rawAttributesChanged returns whether any of the given
+// top-level attributes differs between the two objects.
+func rawAttributesChanged(plan, state tftypes.Value, attributes ...string) bool {
+	for _, attribute := range attributes {
+		attrPath := tftypes.NewAttributePath().WithAttributeName(attribute)
+
+		planValue, _, planErr := tftypes.WalkAttributePath(plan, attrPath)
+		stateValue, _, stateErr := tftypes.WalkAttributePath(state, attrPath)
+		if planErr != nil || stateErr != nil {
+			return true
+		}
+
+		planRaw, planOK := planValue.(tftypes.Value)
+		stateRaw, stateOK := stateValue.(tftypes.Value)
+		if !planOK || !stateOK || !planRaw.Equal(stateRaw) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pricedType contains the pricing of the volume, NodeBalancer
+// and LKE types, which linodego has no dedicated methods for.
+type pricedType struct {
+	ID           string                       `json:"id"`
+	Price        *linodego.LinodePrice        `json:"price"`
+	RegionPrices []linodego.LinodeRegionPrice `json:"region_prices"`
+}
+
+type pricedTypesPage struct {
+	Data []pricedType `json:"data"`
+}
+
+// typeCache memoizes the types used to compute prices for a single client,
+// so reading and planning many resources of the same type only requests
+// the type once. Callers pass the client held by the provider meta so the
+// cache is shared by all resources of a provider instance.
+type typeCache struct {
+	mu          sync.Mutex
+	linodeTypes map[string]*linodego.LinodeType
+	pricedTypes map[string][]pricedType
+}
+
+// typeCaches contains the typeCache of each client by its pointer.
+var typeCaches sync.Map
+
+func getTypeCache(client *linodego.Client) *typeCache {
+	result, _ := typeCaches.LoadOrStore(client, &typeCache{
+		linodeTypes: make(map[string]*linodego.LinodeType),
+		pricedTypes: make(map[string][]pricedType),
+	})
+
+	return result.(*typeCache)
+}
+
+func (c *typeCache) getLinodeType(
+	ctx context.Context,
+	client *linodego.Client,
+	typeID string,
+) (*linodego.LinodeType, error) {
+	c.mu.Lock()
+	result, ok := c.linodeTypes[typeID]
+	c.mu.Unlock()
+
+	if ok {
+		return result, nil
+	}
+
+	tflog.Trace(ctx, "client.GetType(...)", map[string]any{
+		"type": typeID,
+	})
+
+	result, err := client.GetType(ctx, typeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get type %q: %w", typeID, err)
+	}
+
+	c.mu.Lock()
+	c.linodeTypes[typeID] = result
+	c.mu.Unlock()
+
+	return result, nil
+}
+
+func (c *typeCache) listPricedTypes(
+	ctx context.Context,
+	client *linodego.Client,
+	endpoint string,
+) ([]pricedType, error) {
+	c.mu.Lock()
+	result, ok := c.pricedTypes[endpoint]
+	c.mu.Unlock()
+
+	if ok {
+		return result, nil
+	}
+
+	tflog.Trace(ctx, "client.R(...).Get(...)", map[string]any{
+		"endpoint": endpoint,
+	})
+
+	resp, err := client.R(ctx).SetResult(&pricedTypesPage{}).Get(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list types at %s: %w", endpoint, linodego.NewError(err))
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("failed to list types at %s: %w", endpoint, linodego.NewError(resp))
+	}
+
+	page, ok := resp.Result().(*pricedTypesPage)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response listing types at %s", endpoint)
+	}
+
+	c.mu.Lock()
+	c.pricedTypes[endpoint] = page.Data
+	c.mu.Unlock()
+
+	return page.Data, nil
+}
+
+func getEntityTypePrice(
+	ctx context.Context,
+	client *linodego.Client,
+	endpoint, typeID, region string,
+) (Price, error) {
+	pricedTypes, err := getTypeCache(client).listPricedTypes(ctx, client, endpoint)
+	if err != nil {
+		return Price{}, err
+	}
+
+	for _, t := range pricedTypes {
+		if t.ID == typeID {
+			return TypePrice(t.Price, t.RegionPrices, region), nil
+		}
+	}
+
+	return Price{}, fmt.Errorf("type %q was not found at %s", typeID, endpoint)
+}
+
+// float32ToPrice converts a price returned by the API using
+// its shortest decimal representation, e.g. 0.0075 rather
+// than 0.007499999832361937.
+func float32ToPrice(value float32) float64 {
+	result, err := strconv.ParseFloat(strconv.FormatFloat(float64(value), 'f', -1, 32), 64)
+	if err != nil {
+		return float64(value)
+	}
+
+	return result
+}
+
+func roundPrice(value float64) float64 {
+	return math.Round(value*pricePrecision) / pricePrecision
+}
@@ -0,0 +1,105 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/fakeapi"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypePrice(t *testing.T) {
+	price := &linodego.LinodePrice{Hourly: 0.0075, Monthly: 5}
+	regionPrices := []linodego.LinodeRegionPrice{
+		{ID: "id-cgk", Hourly: 0.009, Monthly: 6},
+	}
+
+	assert.Equal(t, helper.Price{Hourly: 0.009, Monthly: 6}, helper.TypePrice(price, regionPrices, "id-cgk"))
+	assert.Equal(t, helper.Price{Hourly: 0.0075, Monthly: 5}, helper.TypePrice(price, regionPrices, "us-east"))
+	assert.Equal(t, helper.Price{}, helper.TypePrice(nil, nil, "us-east"))
+}
+
+func TestPriceArithmetic(t *testing.T) {
+	price := helper.Price{Hourly: 0.00015, Monthly: 0.1}
+
+	assert.Equal(t, helper.Price{Hourly: 0.003, Monthly: 2}, price.Multiply(20))
+	assert.Equal(t,
+		helper.Price{Hourly: 0.0225, Monthly: 15},
+		helper.Price{Hourly: 0.0075, Monthly: 5}.Add(helper.Price{Hourly: 0.015, Monthly: 10}),
+	)
+}
+
+func TestGetPrices(t *testing.T) {
+	ctx := context.Background()
+
+	server := fakeapi.New()
+	t.Cleanup(server.Close)
+
+	client, err := server.Config().Client(ctx)
+	require.NoError(t, err)
+
+	price, err := helper.GetLinodeTypePrice(ctx, client, "g6-nanode-1", "us-east", false)
+	require.NoError(t, err)
+	assert.Equal(t, helper.Price{Hourly: 0.0075, Monthly: 5}, price)
+
+	price, err = helper.GetLinodeTypePrice(ctx, client, "g6-nanode-1", "us-central", false)
+	require.NoError(t, err)
+	assert.Equal(t, helper.Price{Hourly: 0.009, Monthly: 6}, price)
+
+	price, err = helper.GetLinodeTypePrice(ctx, client, "g6-nanode-1", "us-central", true)
+	require.NoError(t, err)
+	assert.Equal(t, helper.Price{Hourly: 0.01125, Monthly: 7.5}, price)
+
+	_, err = helper.GetLinodeTypePrice(ctx, client, "g6-nonexistent-1", "us-east", false)
+	assert.Error(t, err)
+
+	price, err = helper.GetVolumePrice(ctx, client, "us-central", 20)
+	require.NoError(t, err)
+	assert.Equal(t, helper.Price{Hourly: 0.0036, Monthly: 2.4}, price)
+
+	price, err = helper.GetNodeBalancerPrice(ctx, client, "us-east")
+	require.NoError(t, err)
+	assert.Equal(t, helper.Price{Hourly: 0.015, Monthly: 10}, price)
+
+	price, err = helper.GetLKEHAControlPlanePrice(ctx, client, "us-central")
+	require.NoError(t, err)
+	assert.Equal(t, helper.Price{Hourly: 0.108, Monthly: 72}, price)
+}
+
+func TestGetPrices_memoized(t *testing.T) {
+	ctx := context.Background()
+
+	server := fakeapi.New()
+	t.Cleanup(server.Close)
+
+	config := server.Config()
+	config.DisableInternalCache = true
+
+	client, err := config.Client(ctx)
+	require.NoError(t, err)
+
+	for _, region := range []string{"us-east", "us-central", "us-east"} {
+		_, err := helper.GetLinodeTypePrice(ctx, client, "g6-nanode-1", region, true)
+		require.NoError(t, err)
+
+		_, err = helper.GetVolumePrice(ctx, client, region, 20)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 1, server.CountRequests("GET", "linode/types/g6-nanode-1"))
+	assert.Equal(t, 1, server.CountRequests("GET", "volumes/types"))
+
+	// Other clients, e.g. of other provider instances, have their own cache
+	other, err := config.Client(ctx)
+	require.NoError(t, err)
+
+	_, err = helper.GetLinodeTypePrice(ctx, other, "g6-nanode-1", "us-east", false)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, server.CountRequests("GET", "linode/types/g6-nanode-1"))
+}
@@ -0,0 +1,266 @@
+package helper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+const (
+	rateLimitHeaderLimit     = "X-RateLimit-Limit"
+	rateLimitHeaderRemaining = "X-RateLimit-Remaining"
+	rateLimitHeaderReset     = "X-RateLimit-Reset"
+
+	// Requests are spread out until the rate limit window resets
+	// once fewer than 1/rateLimitSlowdownRatio requests remain.
+	rateLimitSlowdownRatio = 10
+)
+
+type rateLimiterKey struct {
+	maxConcurrentRequests int
+	requestsPerSecond     float64
+
+	// credentials is a hash of the Authorization header of the request,
+	// so provider configurations using different tokens or accounts
+	// do not share a rate limit.
+	credentials string
+}
+
+// rateLimiter holds the rate limiting state shared between all clients
+// created with the same settings and credentials, e.g. the SDKv2 and
+// framework providers.
+type rateLimiter struct {
+	limiter   *rate.Limiter
+	semaphore chan struct{}
+
+	mu sync.Mutex
+
+	// nextRequest is the earliest time the next request may be sent
+	// as determined by the X-RateLimit-* response headers.
+	nextRequest time.Time
+
+	// spacing is the delay between requests until spacingExpiry.
+	spacing       time.Duration
+	spacingExpiry time.Time
+}
+
+var (
+	rateLimitersMu sync.Mutex
+	rateLimiters   = make(map[rateLimiterKey]*rateLimiter)
+)
+
+// RateLimitTransport limits the number of concurrent API requests and
+// the rate at which they are sent, and slows down requests as the
+// API's rate limit is approached.
+type RateLimitTransport struct {
+	transport             http.RoundTripper
+	maxConcurrentRequests int
+	requestsPerSecond     float64
+}
+
+// NewRateLimitTransport is a RoundTripper used to rate limit API requests.
+// A value of 0 for maxConcurrentRequests or requestsPerSecond disables
+// the corresponding limit.
+func NewRateLimitTransport(
+	transport http.RoundTripper,
+	maxConcurrentRequests int,
+	requestsPerSecond float64,
+) *RateLimitTransport {
+	return &RateLimitTransport{
+		transport:             transport,
+		maxConcurrentRequests: maxConcurrentRequests,
+		requestsPerSecond:     requestsPerSecond,
+	}
+}
+
+func getRateLimiter(key rateLimiterKey) *rateLimiter {
+	rateLimitersMu.Lock()
+	defer rateLimitersMu.Unlock()
+
+	if result, ok := rateLimiters[key]; ok {
+		return result
+	}
+
+	result := &rateLimiter{}
+
+	if key.requestsPerSecond > 0 {
+		burst := int(math.Max(1, math.Floor(key.requestsPerSecond)))
+		result.limiter = rate.NewLimiter(rate.Limit(key.requestsPerSecond), burst)
+	}
+
+	if key.maxConcurrentRequests > 0 {
+		result.semaphore = make(chan struct{}, key.maxConcurrentRequests)
+	}
+
+	rateLimiters[key] = result
+
+	return result
+}
+
+// RoundTrip waits until the request is allowed to be sent before
+// passing it to the underlying transport.
+func (t *RateLimitTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx := r.Context()
+
+	limiter := getRateLimiter(rateLimiterKey{
+		maxConcurrentRequests: t.maxConcurrentRequests,
+		requestsPerSecond:     t.requestsPerSecond,
+		credentials:           hashCredentials(r.Header.Get("Authorization")),
+	})
+
+	if err := limiter.waitForAPIRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
+	if limiter.limiter != nil {
+		if err := limiter.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if limiter.semaphore != nil {
+		select {
+		case limiter.semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	resp, err := t.transport.RoundTrip(r)
+	if err != nil {
+		limiter.release()
+		return nil, err
+	}
+
+	limiter.observe(ctx, resp.Header)
+
+	// Hold the concurrency slot until the response body has been consumed
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: limiter.release}
+
+	return resp, nil
+}
+
+// hashCredentials returns a hash of the given Authorization header
+// so tokens are not retained as keys of the rate limiters.
+func hashCredentials(authorization string) string {
+	if authorization == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(authorization))
+	return hex.EncodeToString(sum[:])
+}
+
+func (l *rateLimiter) release() {
+	if l.semaphore != nil {
+		<-l.semaphore
+	}
+}
+
+// waitForAPIRateLimit blocks until the X-RateLimit-* headers of previous
+// responses allow another request to be sent.
+func (l *rateLimiter) waitForAPIRateLimit(ctx context.Context) error {
+	l.mu.Lock()
+
+	now := time.Now()
+	sendAt := now
+
+	if l.nextRequest.After(now) {
+		sendAt = l.nextRequest
+	}
+
+	if l.spacing > 0 && sendAt.Before(l.spacingExpiry) {
+		l.nextRequest = sendAt.Add(l.spacing)
+	}
+
+	l.mu.Unlock()
+
+	delay := time.Until(sendAt)
+	if delay <= 0 {
+		return nil
+	}
+
+	tflog.Debug(ctx, "Delaying request to avoid exceeding the API rate limit", map[string]any{
+		"delay": delay.String(),
+	})
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// observe slows down future requests if the given response headers
+// indicate the API rate limit is close to being exceeded.
+func (l *rateLimiter) observe(ctx context.Context, header http.Header) {
+	remaining, err := strconv.Atoi(header.Get(rateLimitHeaderRemaining))
+	if err != nil {
+		return
+	}
+
+	resetUnix, err := strconv.ParseInt(header.Get(rateLimitHeaderReset), 10, 64)
+	if err != nil {
+		return
+	}
+
+	reset := time.Unix(resetUnix, 0)
+	untilReset := time.Until(reset)
+	if untilReset <= 0 {
+		return
+	}
+
+	limit, err := strconv.Atoi(header.Get(rateLimitHeaderLimit))
+	if err != nil {
+		limit = 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	switch {
+	case remaining <= 0:
+		if reset.After(l.nextRequest) {
+			l.nextRequest = reset
+		}
+	case remaining*rateLimitSlowdownRatio < limit:
+		l.spacing = untilReset / time.Duration(remaining+1)
+		l.spacingExpiry = reset
+	default:
+		return
+	}
+
+	tflog.Debug(ctx, "Approaching the API rate limit, slowing down requests", map[string]any{
+		"remaining": remaining,
+		"limit":     limit,
+		"reset":     reset.String(),
+	})
+}
+
+// releaseOnClose releases a concurrency slot once the wrapped
+// response body is closed.
+type releaseOnClose struct {
+	io.ReadCloser
+
+	once    sync.Once
+	release func()
+}
+
+func (r *releaseOnClose) Close() error {
+	err := r.ReadCloser.Close()
+	r.once.Do(r.release)
+	return err
+}
@@ -0,0 +1,159 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func sendRequests(t *testing.T, client *http.Client, url string, count int) {
+	t.Helper()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			resp, err := client.Get(url)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestRateLimitTransport_maxConcurrentRequests(t *testing.T) {
+	var active, maxActive int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&active, 1)
+		defer atomic.AddInt32(&active, -1)
+
+		for {
+			observed := atomic.LoadInt32(&maxActive)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxActive, observed, current) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: helper.NewRateLimitTransport(http.DefaultTransport, 2, 0),
+	}
+
+	sendRequests(t, client, server.URL, 8)
+
+	if maxActive > 2 {
+		t.Errorf("expected at most 2 concurrent requests, got %d", maxActive)
+	}
+}
+
+func TestRateLimitTransport_requestsPerSecond(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: helper.NewRateLimitTransport(http.DefaultTransport, 0, 20),
+	}
+
+	start := time.Now()
+
+	// The first 20 requests are allowed by the burst
+	sendRequests(t, client, server.URL, 25)
+
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("expected requests to be rate limited, finished in %s", elapsed)
+	}
+}
+
+func TestRateLimitTransport_rateLimitHeaders(t *testing.T) {
+	reset := time.Now().Add(2 * time.Second).Truncate(time.Second).Add(time.Second)
+
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.Header().Set("X-RateLimit-Limit", "800")
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		}
+	}))
+	defer server.Close()
+
+	// Use unique settings so the limiter isn't shared with other tests
+	client := &http.Client{
+		Transport: helper.NewRateLimitTransport(http.DefaultTransport, 1000, 0),
+	}
+
+	sendRequests(t, client, server.URL, 1)
+	sendRequests(t, client, server.URL, 1)
+
+	if now := time.Now(); now.Before(reset) {
+		t.Errorf("expected the second request to wait until %s, sent at %s", reset, now)
+	}
+}
+
+func TestRateLimitTransport_perCredentials(t *testing.T) {
+	reset := time.Now().Add(5 * time.Second)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "Bearer throttled" {
+			w.Header().Set("X-RateLimit-Limit", "800")
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		}
+	}))
+	defer server.Close()
+
+	// Use unique settings so the limiter isn't shared with other tests
+	client := &http.Client{
+		Transport: helper.NewRateLimitTransport(http.DefaultTransport, 1001, 0),
+	}
+
+	send := func(token string) {
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}
+
+	send("throttled")
+
+	start := time.Now()
+	send("other")
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected requests using other credentials not to be delayed, took %s", elapsed)
+	}
+}
@@ -0,0 +1,44 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrReadOnly is returned for API requests refused because
+// the provider is configured to be read-only.
+var ErrReadOnly = errors.New("the provider is configured with read_only")
+
+// ReadOnlyTransport refuses all API requests that may mutate the account.
+type ReadOnlyTransport struct {
+	transport http.RoundTripper
+}
+
+// NewReadOnlyTransport is a RoundTripper used to guarantee only GET
+// requests are sent to the API, e.g. when planning untrusted changes.
+func NewReadOnlyTransport(transport http.RoundTripper) *ReadOnlyTransport {
+	return &ReadOnlyTransport{
+		transport: transport,
+	}
+}
+
+// RoundTrip passes GET requests to the underlying transport
+// and refuses all other requests.
+func (t *ReadOnlyTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Method == http.MethodGet {
+		return t.transport.RoundTrip(r)
+	}
+
+	if r.Body != nil {
+		r.Body.Close()
+	}
+
+	endpoint := fmt.Sprintf("%s %s", r.Method, r.URL.Path)
+
+	if resource := APIRequestResource(r.Context()); resource != "" {
+		return nil, fmt.Errorf("refusing to send %s for %s: %w", endpoint, resource, ErrReadOnly)
+	}
+
+	return nil, fmt.Errorf("refusing to send %s: %w", endpoint, ErrReadOnly)
+}
@@ -0,0 +1,50 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOnlyTransport(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 123}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := linodego.NewClient(&http.Client{
+		Transport: helper.NewReadOnlyTransport(http.DefaultTransport),
+	})
+	client.SetBaseURL(server.URL)
+
+	ctx := helper.WithAPIRequestResource(context.Background(), "linode_instance")
+
+	_, err := client.GetInstance(ctx, 123)
+	require.NoError(t, err)
+
+	_, err = client.CreateInstance(ctx, linodego.InstanceCreateOptions{Label: "foo"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "refusing to send POST /v4/linode/instances for linode_instance")
+	assert.Contains(t, err.Error(), helper.ErrReadOnly.Error())
+
+	err = client.DeleteInstance(context.Background(), 123)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "refusing to send DELETE /v4/linode/instances/123:")
+
+	// Refused requests are neither sent nor retried
+	assert.EqualValues(t, 1, requests.Load())
+}
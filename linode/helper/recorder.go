@@ -0,0 +1,365 @@
+package helper
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	EnvRecorderMode     = "LINODE_RECORDER_MODE"
+	EnvRecorderCassette = "LINODE_RECORDER_CASSETTE"
+)
+
+// RecorderMode determines whether API interactions are recorded to
+// or replayed from a cassette file.
+type RecorderMode string
+
+const (
+	RecorderModeDisabled RecorderMode = ""
+	RecorderModeRecord   RecorderMode = "record"
+	RecorderModeReplay   RecorderMode = "replay"
+)
+
+// recordedInteraction is a single API request and its response
+// as stored in a cassette file.
+type recordedInteraction struct {
+	Method       string      `json:"method"`
+	URL          string      `json:"url"`
+	Filter       string      `json:"filter,omitempty"`
+	RequestBody  string      `json:"request_body,omitempty"`
+	StatusCode   int         `json:"status_code"`
+	Header       http.Header `json:"header"`
+	ResponseBody string      `json:"response_body"`
+
+	used bool
+}
+
+func (i *recordedInteraction) endpoint() string {
+	return fmt.Sprintf("%s %s", i.Method, i.URL)
+}
+
+// matchScore ranks how closely a recorded interaction matches a request
+// for the same endpoint.
+func (i *recordedInteraction) matchScore(request *recordedInteraction) int {
+	score := 0
+
+	if i.Filter == request.Filter {
+		score += 2
+	}
+
+	if i.RequestBody == request.RequestBody {
+		score++
+	}
+
+	return score
+}
+
+// RecorderTransport records API interactions to a cassette file or
+// replays them from a previously recorded one.
+//
+// Request headers are never written to the cassette, so the
+// Authorization header is not persisted. Sensitive request and response
+// body fields (e.g. root_pass, secret_key, kubeconfig) are redacted.
+type RecorderTransport struct {
+	transport http.RoundTripper
+	cassette  *recorderCassette
+}
+
+// recorderCassette is the state of a cassette shared between all
+// transports recording to or replaying from the same file.
+type recorderCassette struct {
+	mode RecorderMode
+	path string
+
+	mu           sync.Mutex
+	interactions []*recordedInteraction
+	lastReplayed map[string]*recordedInteraction
+}
+
+var (
+	recorderMu           sync.Mutex
+	recorderMode         RecorderMode
+	recorderCassettePath string
+	recorderCassettes    = make(map[string]*recorderCassette)
+)
+
+// ConfigureRecorder sets the recorder mode and cassette used by all
+// Linode clients created after this call.
+func ConfigureRecorder(mode RecorderMode, cassettePath string) {
+	recorderMu.Lock()
+	defer recorderMu.Unlock()
+
+	recorderMode = mode
+	recorderCassettePath = cassettePath
+}
+
+// GetRecorderMode returns the currently configured recorder mode.
+func GetRecorderMode() RecorderMode {
+	recorderMu.Lock()
+	defer recorderMu.Unlock()
+
+	return recorderMode
+}
+
+// WrapRecorderTransport wraps the given transport with the configured
+// recorder. Clients sharing a cassette share its state so interactions
+// are stored in the order they were made, while each client keeps
+// its own underlying transport.
+func WrapRecorderTransport(transport http.RoundTripper) (http.RoundTripper, error) {
+	recorderMu.Lock()
+	defer recorderMu.Unlock()
+
+	if recorderMode == RecorderModeDisabled {
+		return transport, nil
+	}
+
+	cassette, ok := recorderCassettes[recorderCassettePath]
+	if !ok {
+		var err error
+
+		cassette, err = newRecorderCassette(recorderMode, recorderCassettePath)
+		if err != nil {
+			return nil, err
+		}
+
+		recorderCassettes[recorderCassettePath] = cassette
+	}
+
+	return &RecorderTransport{
+		transport: transport,
+		cassette:  cassette,
+	}, nil
+}
+
+// NewRecorderTransport is a RoundTripper used to record API interactions
+// to or replay them from the given cassette file.
+func NewRecorderTransport(
+	mode RecorderMode,
+	cassettePath string,
+	transport http.RoundTripper,
+) (*RecorderTransport, error) {
+	cassette, err := newRecorderCassette(mode, cassettePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RecorderTransport{
+		transport: transport,
+		cassette:  cassette,
+	}, nil
+}
+
+func newRecorderCassette(mode RecorderMode, cassettePath string) (*recorderCassette, error) {
+	result := &recorderCassette{
+		mode:         mode,
+		path:         cassettePath,
+		lastReplayed: make(map[string]*recordedInteraction),
+	}
+
+	switch mode {
+	case RecorderModeRecord:
+		if err := os.MkdirAll(filepath.Dir(cassettePath), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create cassette directory: %w", err)
+		}
+
+		if err := os.WriteFile(cassettePath, nil, 0o600); err != nil {
+			return nil, fmt.Errorf("failed to truncate cassette: %w", err)
+		}
+	case RecorderModeReplay:
+		if err := result.load(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown recorder mode %q", mode)
+	}
+
+	return result, nil
+}
+
+// RoundTrip records or replays the given API request.
+func (t *RecorderTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r, requestBody, err := readRequestBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction := &recordedInteraction{
+		Method:      r.Method,
+		URL:         r.URL.RequestURI(),
+		Filter:      r.Header.Get("X-Filter"),
+		RequestBody: redactRecordedBody(requestBody),
+	}
+
+	if t.cassette.mode == RecorderModeReplay {
+		return t.cassette.replay(r, interaction)
+	}
+
+	return t.record(r, interaction)
+}
+
+func (t *RecorderTransport) record(r *http.Request, interaction *recordedInteraction) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(r)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	interaction.StatusCode = resp.StatusCode
+	interaction.Header = resp.Header.Clone()
+	interaction.Header.Del("Set-Cookie")
+	interaction.ResponseBody = redactRecordedBody(string(responseBody))
+
+	if err := t.cassette.append(interaction); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (c *recorderCassette) replay(r *http.Request, request *recordedInteraction) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	endpoint := request.endpoint()
+
+	// Requests are matched by endpoint, preferring the earliest unused
+	// interaction with the same filter and body. Filters and bodies can
+	// differ between runs since they may contain random values
+	// (e.g. generated root passwords).
+	var match *recordedInteraction
+	for _, candidate := range c.interactions {
+		if candidate.used || candidate.endpoint() != endpoint {
+			continue
+		}
+
+		if match == nil || candidate.matchScore(request) > match.matchScore(request) {
+			match = candidate
+		}
+	}
+
+	if match != nil {
+		match.used = true
+		c.lastReplayed[endpoint] = match
+	} else if last, ok := c.lastReplayed[endpoint]; ok {
+		// Repeat the final response for requests that were polled
+		// more often than during recording.
+		match = last
+	} else {
+		return nil, fmt.Errorf(
+			"no recorded interaction for %s in cassette %s",
+			endpoint, c.path,
+		)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", match.StatusCode, http.StatusText(match.StatusCode)),
+		StatusCode:    match.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        match.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader([]byte(match.ResponseBody))),
+		ContentLength: int64(len(match.ResponseBody)),
+		Request:       r,
+	}, nil
+}
+
+// append writes an interaction as a single JSON line so
+// the cassette remains valid if the test process is interrupted.
+func (c *recorderCassette) append(interaction *recordedInteraction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	line, err := json.Marshal(interaction)
+	if err != nil {
+		return fmt.Errorf("failed to marshal interaction: %w", err)
+	}
+
+	f, err := os.OpenFile(c.path, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open cassette: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+
+	c.interactions = append(c.interactions, interaction)
+
+	return nil
+}
+
+func (c *recorderCassette) load() error {
+	f, err := os.Open(c.path)
+	if err != nil {
+		return fmt.Errorf("failed to open cassette: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 64*1024*1024)
+
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var interaction recordedInteraction
+		if err := json.Unmarshal(scanner.Bytes(), &interaction); err != nil {
+			return fmt.Errorf("failed to parse cassette %s: %w", c.path, err)
+		}
+
+		c.interactions = append(c.interactions, &interaction)
+	}
+
+	return scanner.Err()
+}
+
+// readRequestBody reads the body of the given request, returning a
+// copy of the request with a body that can be read again.
+func readRequestBody(r *http.Request) (*http.Request, string, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return r, "", nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	result := r.Clone(r.Context())
+	result.Body = io.NopCloser(bytes.NewReader(body))
+
+	return result, string(body), nil
+}
+
+// redactRecordedBody redacts the values of sensitive fields in the given
+// JSON body. Bodies that are not JSON are recorded as is.
+func redactRecordedBody(body string) string {
+	if body == "" {
+		return body
+	}
+
+	redacted := redactJSONBody([]byte(body))
+	if redacted == nil {
+		return body
+	}
+
+	return string(redacted)
+}
@@ -0,0 +1,206 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/fakeapi"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func newRecorderClient(
+	t *testing.T,
+	mode helper.RecorderMode,
+	cassettePath, baseURL string,
+) *linodego.Client {
+	t.Helper()
+
+	transport, err := helper.NewRecorderTransport(mode, cassettePath, http.DefaultTransport)
+	if err != nil {
+		t.Fatalf("failed to create recorder transport: %s", err)
+	}
+
+	client := linodego.NewClient(&http.Client{Transport: transport})
+	client.SetBaseURL(baseURL)
+	client.SetAPIVersion("v4")
+	client.SetToken(fakeapi.Token)
+
+	return &client
+}
+
+func TestRecorderTransport_recordReplay(t *testing.T) {
+	ctx := context.Background()
+	cassettePath := filepath.Join(t.TempDir(), "cassettes", "recorder.jsonl")
+
+	server := fakeapi.New()
+	baseURL := server.URL
+
+	client := newRecorderClient(t, helper.RecorderModeRecord, cassettePath, baseURL)
+
+	volume, err := client.CreateVolume(ctx, linodego.VolumeCreateOptions{
+		Label:  "recorded-volume",
+		Region: "us-east",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetVolume(ctx, volume.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	server.Close()
+
+	cassette, err := os.ReadFile(cassettePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(string(cassette), fakeapi.Token) {
+		t.Fatal("expected the access token to be excluded from the cassette")
+	}
+
+	// The server is closed, so all responses must come from the cassette
+	client = newRecorderClient(t, helper.RecorderModeReplay, cassettePath, baseURL)
+
+	replayed, err := client.CreateVolume(ctx, linodego.VolumeCreateOptions{
+		Label:  "recorded-volume",
+		Region: "us-east",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if replayed.ID != volume.ID {
+		t.Errorf("expected replayed volume ID %d, got %d", volume.ID, replayed.ID)
+	}
+
+	// Polled requests repeat the last recorded response
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetVolume(ctx, volume.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := client.ListVolumes(ctx, nil); err == nil {
+		t.Error("expected an error for a request missing from the cassette")
+	}
+}
+
+func TestRecorderTransport_redactsSensitiveFields(t *testing.T) {
+	ctx := context.Background()
+	cassettePath := filepath.Join(t.TempDir(), "recorder.jsonl")
+
+	server := fakeapi.New()
+	defer server.Close()
+
+	client := newRecorderClient(t, helper.RecorderModeRecord, cassettePath, server.URL)
+
+	rootPass := "sup3r-s3cr3t-r00t-p@ss"
+
+	instance, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{
+		Label:    "recorded-instance",
+		Region:   "us-east",
+		Type:     "g6-nanode-1",
+		Image:    "linode/debian12",
+		RootPass: rootPass,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cassette, err := os.ReadFile(cassettePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(string(cassette), rootPass) {
+		t.Fatal("expected root_pass to be redacted from the cassette")
+	}
+
+	if !strings.Contains(string(cassette), "recorded-instance") {
+		t.Fatal("expected non-sensitive fields to be recorded")
+	}
+
+	// Requests with a different root_pass still match the redacted interaction
+	client = newRecorderClient(t, helper.RecorderModeReplay, cassettePath, server.URL)
+
+	replayed, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{
+		Label:    "recorded-instance",
+		Region:   "us-east",
+		Type:     "g6-nanode-1",
+		Image:    "linode/debian12",
+		RootPass: "an0ther-r00t-p@ss",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if replayed.ID != instance.ID {
+		t.Errorf("expected replayed instance ID %d, got %d", instance.ID, replayed.ID)
+	}
+}
+
+type countingTransport struct {
+	transport http.RoundTripper
+	requests  int
+}
+
+func (t *countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	t.requests++
+	return t.transport.RoundTrip(r)
+}
+
+func TestWrapRecorderTransport_keepsInnerTransport(t *testing.T) {
+	ctx := context.Background()
+	cassettePath := filepath.Join(t.TempDir(), "wrapped.jsonl")
+
+	server := fakeapi.New()
+	defer server.Close()
+
+	helper.ConfigureRecorder(helper.RecorderModeRecord, cassettePath)
+	defer helper.ConfigureRecorder(helper.RecorderModeDisabled, "")
+
+	inner := []*countingTransport{
+		{transport: http.DefaultTransport},
+		{transport: http.DefaultTransport},
+	}
+
+	for _, transport := range inner {
+		wrapped, err := helper.WrapRecorderTransport(transport)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		client := linodego.NewClient(&http.Client{Transport: wrapped})
+		client.SetBaseURL(server.URL)
+		client.SetAPIVersion("v4")
+		client.SetToken(fakeapi.Token)
+
+		if _, err := client.ListRegions(ctx, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i, transport := range inner {
+		if transport.requests != 1 {
+			t.Errorf("expected client %d to use its own transport, got %d requests", i, transport.requests)
+		}
+	}
+
+	cassette, err := os.ReadFile(cassettePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if lines := strings.Count(string(cassette), "\n"); lines != 2 {
+		t.Errorf("expected both clients to record to the shared cassette, got %d interactions", lines)
+	}
+}
@@ -0,0 +1,84 @@
+package helper
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// redactedValue replaces the values of sensitive body fields.
+const redactedValue = "REDACTED"
+
+var (
+	// sensitiveFields are the body fields whose values are never written
+	// to API recorder cassettes or the audit log.
+	sensitiveFields = map[string]bool{
+		"root_pass":       true,
+		"token":           true,
+		"user_data":       true,
+		"ssl_key":         true,
+		"private_key":     true,
+		"authorized_keys": true,
+		"kubeconfig":      true,
+		"access_key":      true,
+	}
+
+	// sensitiveFieldSubstrings redact any body field
+	// containing one of the substrings, e.g. `secret_key`.
+	sensitiveFieldSubstrings = []string{"password", "secret"}
+)
+
+// redactJSONBody returns the given JSON body with the values of
+// sensitive fields redacted, or nil if the body is not JSON.
+func redactJSONBody(body []byte) json.RawMessage {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil
+	}
+
+	result, err := json.Marshal(redactJSONValue(value))
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+func redactJSONValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, fieldValue := range v {
+			if isSensitiveField(key) {
+				v[key] = redactedValue
+				continue
+			}
+
+			v[key] = redactJSONValue(fieldValue)
+		}
+	case []any:
+		for i, elem := range v {
+			v[i] = redactJSONValue(elem)
+		}
+	}
+
+	return value
+}
+
+func isSensitiveField(field string) bool {
+	field = strings.ToLower(field)
+
+	if sensitiveFields[field] {
+		return true
+	}
+
+	for _, substring := range sensitiveFieldSubstrings {
+		if strings.Contains(field, substring) {
+			return true
+		}
+	}
+
+	return false
+}
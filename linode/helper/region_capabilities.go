@@ -0,0 +1,123 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+)
+
+// CheckRegionCapabilities returns an error naming the first of the given
+// capabilities that is not supported by the given region or not available
+// to the account in the region.
+//
+// Failures to look up the region or the account availability are logged
+// and ignored so the API can still reject the request at apply time.
+func CheckRegionCapabilities(
+	ctx context.Context,
+	client *linodego.Client,
+	regionID string,
+	capabilities ...string,
+) error {
+	if len(capabilities) == 0 {
+		return nil
+	}
+
+	ctx = tflog.SetField(ctx, "region", regionID)
+
+	tflog.Trace(ctx, "client.GetRegion(...)")
+
+	region, err := client.GetRegion(ctx, regionID)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to get region, skipping capability validation", map[string]any{
+			"error": err.Error(),
+		})
+		return nil
+	}
+
+	for _, capability := range capabilities {
+		if !slices.Contains(region.Capabilities, capability) {
+			return fmt.Errorf("region %q does not support the %q capability", regionID, capability)
+		}
+	}
+
+	tflog.Trace(ctx, "client.GetAccountAvailability(...)")
+
+	availability, err := client.GetAccountAvailability(ctx, regionID)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to get account availability, skipping availability validation", map[string]any{
+			"error": err.Error(),
+		})
+		return nil
+	}
+
+	for _, capability := range capabilities {
+		if slices.Contains(availability.Unavailable, capability) {
+			return fmt.Errorf(
+				"the %q capability is not available to this account in region %q", capability, regionID,
+			)
+		}
+	}
+
+	return nil
+}
+
+// FrameworkCheckRegionCapabilities adds an attribute error to the given diagnostics
+// if the planned region does not support all of the given capabilities.
+// Unknown and null regions are not validated.
+func FrameworkCheckRegionCapabilities(
+	ctx context.Context,
+	meta *FrameworkProviderMeta,
+	region types.String,
+	attrPath path.Path,
+	diags *diag.Diagnostics,
+	capabilities ...string,
+) {
+	if meta == nil || region.IsUnknown() || region.IsNull() {
+		return
+	}
+
+	if err := CheckRegionCapabilities(ctx, meta.Client, region.ValueString(), capabilities...); err != nil {
+		diags.AddAttributeError(attrPath, "Unsupported Region Capability", err.Error())
+	}
+}
+
+// FrameworkModifyPlanRegionCapabilities validates that the planned `region` attribute
+// of a new resource, or a resource being moved to another region, supports all of
+// the given capabilities.
+func FrameworkModifyPlanRegionCapabilities(
+	ctx context.Context,
+	meta *FrameworkProviderMeta,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+	capabilities ...string,
+) {
+	// Nothing to do for destroy plans
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planRegion types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("region"), &planRegion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateRegion types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("region"), &stateRegion)...)
+		if resp.Diagnostics.HasError() || stateRegion.Equal(planRegion) {
+			return
+		}
+	}
+
+	FrameworkCheckRegionCapabilities(
+		ctx, meta, planRegion, path.Root("region"), &resp.Diagnostics, capabilities...,
+	)
+}
@@ -0,0 +1,46 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/fakeapi"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckRegionCapabilities(t *testing.T) {
+	ctx := context.Background()
+
+	server := fakeapi.New()
+	t.Cleanup(server.Close)
+
+	client, err := server.Config().Client(ctx)
+	require.NoError(t, err)
+
+	assert.NoError(t, helper.CheckRegionCapabilities(
+		ctx, client, "us-east", linodego.CapabilityVPCs, linodego.CapabilityBlockStorage,
+	))
+
+	assert.EqualError(t,
+		helper.CheckRegionCapabilities(ctx, client, "us-southeast", linodego.CapabilityBlockStorage),
+		`region "us-southeast" does not support the "Block Storage" capability`,
+	)
+
+	assert.EqualError(t,
+		helper.CheckRegionCapabilities(ctx, client, "us-central", linodego.CapabilityBlockStorage),
+		`the "Block Storage" capability is not available to this account in region "us-central"`,
+	)
+
+	// Failed lookups are left to the API to reject at apply time
+	assert.NoError(t, helper.CheckRegionCapabilities(ctx, client, "nowhere", linodego.CapabilityVPCs))
+
+	// No requests are made without required capabilities
+	requests := len(server.Requests())
+	assert.NoError(t, helper.CheckRegionCapabilities(ctx, client, "us-southeast"))
+	assert.Len(t, server.Requests(), requests)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -46,3 +47,23 @@ func GetDataSourceMeta(
 
 	return meta
 }
+
+func GetEphemeralResourceMeta(
+	req ephemeral.ConfigureRequest,
+	resp *ephemeral.ConfigureResponse,
+) *FrameworkProviderMeta {
+	meta, ok := req.ProviderData.(*FrameworkProviderMeta)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf(
+				"Expected *http.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return nil
+	}
+
+	return meta
+}
@@ -1,6 +1,7 @@
 package helper
 
 import (
+	"fmt"
 	"log"
 	"net/url"
 	"regexp"
@@ -57,3 +58,62 @@ func ApplyAllRetryConditions(client *linodego.Client) {
 	client.AddRetryCondition(LinodeInstance500Retry())
 	client.AddRetryCondition(ImageUpload500Retry())
 }
+
+// DefaultRetryRuleMaxAttempts is the maximum number of attempts
+// of a request matching a retry rule without `max_attempts`.
+const DefaultRetryRuleMaxAttempts = 5
+
+// RetryRule is a user-configured rule retrying requests to the paths matching
+// PathRegex that fail with any of the StatusCodes.
+type RetryRule struct {
+	StatusCodes []int
+	PathRegex   string
+	MaxAttempts int
+}
+
+// RetryConditions compiles the rule into a GenericRetryCondition for each
+// of its status codes, limited to the maximum attempts of the rule.
+func (r RetryRule) RetryConditions() ([]linodego.RetryConditional, error) {
+	pathPattern, err := regexp.Compile(r.PathRegex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid retry rule path_regex %q: %w", r.PathRegex, err)
+	}
+
+	maxAttempts := r.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultRetryRuleMaxAttempts
+	}
+
+	result := make([]linodego.RetryConditional, len(r.StatusCodes))
+
+	for i, statusCode := range r.StatusCodes {
+		condition := GenericRetryCondition(statusCode, pathPattern)
+
+		result[i] = func(response *resty.Response, err error) bool {
+			if response.Request == nil || response.Request.Attempt >= maxAttempts {
+				return false
+			}
+
+			return condition(response, err)
+		}
+	}
+
+	return result, nil
+}
+
+// ApplyRetryRules adds the retry conditions of the given
+// user-configured rules alongside the built-in conditions.
+func ApplyRetryRules(client *linodego.Client, rules []RetryRule) error {
+	for _, rule := range rules {
+		conditions, err := rule.RetryConditions()
+		if err != nil {
+			return err
+		}
+
+		for _, condition := range conditions {
+			client.AddRetryCondition(condition)
+		}
+	}
+
+	return nil
+}
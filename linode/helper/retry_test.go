@@ -0,0 +1,98 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryRules(t *testing.T) {
+	var attempts atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/linode/instances/123") {
+			attempts.Add(1)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte(`{"errors": [{"reason": "Bad Gateway"}]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	testCases := []struct {
+		name     string
+		rules    []helper.RetryRule
+		expected int32
+	}{
+		{
+			name:     "no rules",
+			expected: 1,
+		},
+		{
+			name: "matching rule",
+			rules: []helper.RetryRule{
+				{StatusCodes: []int{500, 502}, PathRegex: "linode/instances/[0-9]+$", MaxAttempts: 3},
+			},
+			expected: 3,
+		},
+		{
+			name: "default max attempts",
+			rules: []helper.RetryRule{
+				{StatusCodes: []int{502}, PathRegex: "linode/instances"},
+			},
+			expected: helper.DefaultRetryRuleMaxAttempts,
+		},
+		{
+			name: "mismatched status code",
+			rules: []helper.RetryRule{
+				{StatusCodes: []int{500}, PathRegex: "linode/instances/[0-9]+$", MaxAttempts: 3},
+			},
+			expected: 1,
+		},
+		{
+			name: "mismatched path",
+			rules: []helper.RetryRule{
+				{StatusCodes: []int{502}, PathRegex: "volumes/[0-9]+$", MaxAttempts: 3},
+			},
+			expected: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			attempts.Store(0)
+
+			config := &helper.Config{
+				APIURL:                    server.URL,
+				APIVersion:                "v4",
+				AccessToken:               "token",
+				ConfigPath:                t.TempDir() + "/config",
+				MinRetryDelayMilliseconds: 1,
+				MaxRetryDelayMilliseconds: 1,
+				RetryRules:                testCase.rules,
+			}
+
+			client, err := config.Client(context.Background())
+			require.NoError(t, err)
+
+			_, err = client.GetInstance(context.Background(), 123)
+			assert.Error(t, err)
+			assert.Equal(t, testCase.expected, attempts.Load())
+		})
+	}
+}
+
+func TestRetryRulesInvalidPathRegex(t *testing.T) {
+	_, err := helper.RetryRule{StatusCodes: []int{502}, PathRegex: "linode/("}.RetryConditions()
+	assert.Error(t, err)
+}
@@ -0,0 +1,297 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProviderTags contains the provider-level tag configuration
+// applied to all taggable resources.
+//
+// Tags are compared case-insensitively to match the Linode API.
+type ProviderTags struct {
+	// DefaultTags are merged into the tags of every taggable resource.
+	DefaultTags []string
+
+	// IgnoreTags are prefixes of tags managed outside of Terraform.
+	// Matching tags are excluded from state and preserved on update.
+	IgnoreTags []string
+}
+
+// GetProviderTags returns the provider-level tag configuration.
+func (c *Config) GetProviderTags() ProviderTags {
+	if c == nil {
+		return ProviderTags{}
+	}
+
+	return ProviderTags{
+		DefaultTags: c.DefaultTags,
+		IgnoreTags:  c.IgnoreTags,
+	}
+}
+
+// GetProviderTags returns the provider-level tag configuration.
+// Known string sets can always be converted, so conversion
+// diagnostics are not returned.
+func (m *FrameworkProviderModel) GetProviderTags(ctx context.Context) ProviderTags {
+	var result ProviderTags
+
+	if m == nil {
+		return result
+	}
+
+	if !m.DefaultTags.IsNull() && !m.DefaultTags.IsUnknown() {
+		m.DefaultTags.ElementsAs(ctx, &result.DefaultTags, false)
+	}
+
+	if !m.IgnoreTags.IsNull() && !m.IgnoreTags.IsUnknown() {
+		m.IgnoreTags.ElementsAs(ctx, &result.IgnoreTags, false)
+	}
+
+	return result
+}
+
+// IsIgnored returns whether the given tag matches any of the ignored prefixes.
+func (p ProviderTags) IsIgnored(tag string) bool {
+	for _, prefix := range p.IgnoreTags {
+		if strings.HasPrefix(strings.ToLower(tag), strings.ToLower(prefix)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasIgnoreTags returns whether any tag prefixes are ignored.
+func (p ProviderTags) HasIgnoreTags() bool {
+	return len(p.IgnoreTags) > 0
+}
+
+// MergeTags returns the given resource tags merged with the default tags.
+func (p ProviderTags) MergeTags(tags []string) []string {
+	return mergeTags(tags, p.DefaultTags)
+}
+
+// UpdateTags returns the tags to send when updating a resource,
+// preserving any ignored tags currently applied to the resource.
+func (p ProviderTags) UpdateTags(tags, remoteTags []string) []string {
+	result := p.MergeTags(tags)
+
+	for _, tag := range remoteTags {
+		if p.IsIgnored(tag) {
+			result = mergeTags(result, []string{tag})
+		}
+	}
+
+	return result
+}
+
+// PlanTagsAll returns the expected tags_all value for the given resource tags.
+func (p ProviderTags) PlanTagsAll(tags []string) []string {
+	result := make([]string, 0)
+
+	for _, tag := range p.MergeTags(tags) {
+		if p.IsIgnored(tag) && !containsTag(tags, tag) {
+			continue
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// FlattenTags returns the tags and tags_all values to store in state for
+// the tags returned by the API. Default tags are only included in tags if
+// they were previously configured on the resource.
+func (p ProviderTags) FlattenTags(remoteTags, priorTags []string) (tags, tagsAll []string) {
+	tags = make([]string, 0)
+	tagsAll = make([]string, 0)
+
+	for _, tag := range remoteTags {
+		configured := containsTag(priorTags, tag)
+
+		if p.IsIgnored(tag) && !configured {
+			continue
+		}
+
+		tagsAll = append(tagsAll, tag)
+
+		if containsTag(p.DefaultTags, tag) && !configured {
+			continue
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return tags, tagsAll
+}
+
+// SDKv2SetTags sets the tags and tags_all attributes of an
+// SDKv2 resource from the tags returned by the API.
+func (p ProviderTags) SDKv2SetTags(d *schema.ResourceData, remoteTags []string) {
+	tags, tagsAll := p.FlattenTags(remoteTags, ExpandStringSet(d.Get("tags").(*schema.Set)))
+
+	d.Set("tags", tags)
+	d.Set("tags_all", tagsAll)
+}
+
+// FrameworkFlattenTags returns the tags and tags_all values to store in state
+// for the tags returned by the API. The prior tags value must be either a
+// types.Set or types.List.
+func (p ProviderTags) FrameworkFlattenTags(
+	ctx context.Context,
+	remoteTags []string,
+	priorTags attr.Value,
+) (tags []string, tagsAll types.Set, diags diag.Diagnostics) {
+	priorTagsSlice, diags := frameworkTagsToSlice(ctx, priorTags)
+	if diags.HasError() {
+		return nil, tagsAll, diags
+	}
+
+	tags, tagsAllSlice := p.FlattenTags(remoteTags, priorTagsSlice)
+
+	tagsAll, d := types.SetValueFrom(ctx, types.StringType, tagsAllSlice)
+	diags.Append(d...)
+
+	return tags, tagsAll, diags
+}
+
+func frameworkTagsToSlice(ctx context.Context, value attr.Value) ([]string, diag.Diagnostics) {
+	var result []string
+	var diags diag.Diagnostics
+
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return result, diags
+	}
+
+	switch v := value.(type) {
+	case types.Set:
+		diags.Append(v.ElementsAs(ctx, &result, false)...)
+	case types.List:
+		diags.Append(v.ElementsAs(ctx, &result, false)...)
+	default:
+		diags.AddError("Unsupported Tags Type", fmt.Sprintf("Unsupported tags type: %T", value))
+	}
+
+	return result, diags
+}
+
+// CompareTags returns whether the given tags are equal, ignoring order and case.
+func CompareTags(a, b []string) bool {
+	a, b = mergeTags(a, nil), mergeTags(b, nil)
+
+	return len(a) == len(b) && len(mergeTags(a, b)) == len(a)
+}
+
+// FrameworkModifyPlanTagsAll computes the planned tags_all attribute
+// of a framework resource from its planned tags attribute.
+func FrameworkModifyPlanTagsAll(
+	ctx context.Context,
+	meta *FrameworkProviderMeta,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to do for destroy plans
+	if req.Plan.Raw.IsNull() || meta == nil {
+		return
+	}
+
+	tagsType, d := req.Plan.Schema.TypeAtPath(ctx, path.Root("tags"))
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tags attr.Value
+	if _, ok := tagsType.(types.ListType); ok {
+		var tagsList types.List
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tagsList)...)
+		tags = tagsList
+	} else {
+		var tagsSet types.Set
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tagsSet)...)
+		tags = tagsSet
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if tags.IsUnknown() {
+		resp.Diagnostics.Append(
+			resp.Plan.SetAttribute(ctx, path.Root("tags_all"), types.SetUnknown(types.StringType))...,
+		)
+		return
+	}
+
+	providerTags := meta.Config.GetProviderTags(ctx)
+
+	tagsSlice, d := frameworkTagsToSlice(ctx, tags)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAll, d := types.SetValueFrom(ctx, types.StringType, providerTags.PlanTagsAll(tagsSlice))
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var stateTagsAll types.Set
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tags_all"), &stateTagsAll)...)
+	}
+
+	// Keep the casing of the existing value to avoid unnecessary diffs
+	if compareTagSets(ctx, stateTagsAll, tagsAll) {
+		tagsAll = stateTagsAll
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+func compareTagSets(ctx context.Context, a, b types.Set) bool {
+	if a.IsNull() || a.IsUnknown() || b.IsNull() || b.IsUnknown() {
+		return false
+	}
+
+	var aTags, bTags []string
+	if a.ElementsAs(ctx, &aTags, false).HasError() || b.ElementsAs(ctx, &bTags, false).HasError() {
+		return false
+	}
+
+	return CompareTags(aTags, bTags)
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func mergeTags(tags, additional []string) []string {
+	result := make([]string, 0, len(tags)+len(additional))
+
+	for _, tag := range append(append([]string{}, tags...), additional...) {
+		if !containsTag(result, tag) {
+			result = append(result, tag)
+		}
+	}
+
+	return result
+}
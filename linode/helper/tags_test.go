@@ -0,0 +1,77 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+var testProviderTags = helper.ProviderTags{
+	DefaultTags: []string{"env:test", "team:infra"},
+	IgnoreTags:  []string{"external:"},
+}
+
+func TestProviderTags_MergeTags(t *testing.T) {
+	result := testProviderTags.MergeTags([]string{"foo", "ENV:test"})
+
+	expected := []string{"foo", "ENV:test", "team:infra"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestProviderTags_UpdateTags(t *testing.T) {
+	result := testProviderTags.UpdateTags(
+		[]string{"foo"},
+		[]string{"bar", "external:owner", "env:test"},
+	)
+
+	expected := []string{"foo", "env:test", "team:infra", "external:owner"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestProviderTags_PlanTagsAll(t *testing.T) {
+	providerTags := helper.ProviderTags{
+		DefaultTags: []string{"env:test", "external:default"},
+		IgnoreTags:  []string{"external:"},
+	}
+
+	result := providerTags.PlanTagsAll([]string{"foo", "external:configured"})
+
+	expected := []string{"foo", "external:configured", "env:test"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestProviderTags_FlattenTags(t *testing.T) {
+	tags, tagsAll := testProviderTags.FlattenTags(
+		[]string{"foo", "env:test", "team:infra", "external:owner"},
+		[]string{"foo", "team:infra"},
+	)
+
+	expectedTags := []string{"foo", "team:infra"}
+	if !reflect.DeepEqual(tags, expectedTags) {
+		t.Errorf("expected tags %v, got %v", expectedTags, tags)
+	}
+
+	expectedTagsAll := []string{"foo", "env:test", "team:infra"}
+	if !reflect.DeepEqual(tagsAll, expectedTagsAll) {
+		t.Errorf("expected tags_all %v, got %v", expectedTagsAll, tagsAll)
+	}
+}
+
+func TestCompareTags(t *testing.T) {
+	if !helper.CompareTags([]string{"Foo", "bar"}, []string{"bar", "foo"}) {
+		t.Error("expected tags to be equal")
+	}
+
+	if helper.CompareTags([]string{"foo"}, []string{"foo", "bar"}) {
+		t.Error("expected tags to differ")
+	}
+}
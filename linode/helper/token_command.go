@@ -0,0 +1,146 @@
+package helper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// tokenCommandRefreshWindow is how long before its expiry
+// a token returned by the token command is replaced.
+const tokenCommandRefreshWindow = time.Minute
+
+// tokenCommandOutput is the JSON output of a token command
+// that returns a token with an expiry.
+type tokenCommandOutput struct {
+	Token  string     `json:"token"`
+	Expiry *time.Time `json:"expiry"`
+}
+
+// TokenCommandTransport authenticates API requests using a token
+// printed by an external command, e.g. a secrets manager CLI.
+type TokenCommandTransport struct {
+	transport http.RoundTripper
+	command   []string
+
+	mu     sync.Mutex
+	token  string
+	expiry *time.Time
+}
+
+// NewTokenCommandTransport is a RoundTripper used to authenticate API
+// requests using the token printed by the given command. The token is
+// cached and the command is run again once the token expires.
+func NewTokenCommandTransport(transport http.RoundTripper, command []string) *TokenCommandTransport {
+	return &TokenCommandTransport{
+		transport: transport,
+		command:   command,
+	}
+}
+
+// RoundTrip sets the request's credentials to the token
+// printed by the command before passing it to the underlying transport.
+func (t *TokenCommandTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	token, err := t.getToken(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	r = r.Clone(r.Context())
+	r.Header.Set("Authorization", "Bearer "+token)
+
+	return t.transport.RoundTrip(r)
+}
+
+// getToken returns the cached token, running the command if
+// there is no token or the cached token is about to expire.
+func (t *TokenCommandTransport) getToken(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token != "" &&
+		(t.expiry == nil || time.Until(*t.expiry) > tokenCommandRefreshWindow) {
+		return t.token, nil
+	}
+
+	tflog.Debug(ctx, "Running token command", map[string]any{
+		"command": t.command[0],
+	})
+
+	token, expiry, err := RunTokenCommand(ctx, t.command)
+	if err != nil {
+		return "", err
+	}
+
+	t.token = token
+	t.expiry = expiry
+
+	return t.token, nil
+}
+
+// RunTokenCommand runs the given command and returns the token it prints.
+// The command may either print the token as plain text or print a JSON
+// object with a "token" field and an optional RFC 3339 "expiry" field.
+func RunTokenCommand(ctx context.Context, command []string) (string, *time.Time, error) {
+	if len(command) == 0 || command[0] == "" {
+		return "", nil, fmt.Errorf("token command must not be empty")
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...) // #nosec G204 -- command is user-configured
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", nil, fmt.Errorf(
+			"failed to run token command %q: %w: %s",
+			command[0], err, strings.TrimSpace(stderr.String()),
+		)
+	}
+
+	output := strings.TrimSpace(stdout.String())
+
+	if !strings.HasPrefix(output, "{") {
+		if output == "" {
+			return "", nil, fmt.Errorf("token command %q returned an empty token", command[0])
+		}
+
+		return output, nil, nil
+	}
+
+	var result tokenCommandOutput
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		return "", nil, fmt.Errorf("failed to parse output of token command %q: %w", command[0], err)
+	}
+
+	if result.Token == "" {
+		return "", nil, fmt.Errorf("token command %q returned an empty token", command[0])
+	}
+
+	return result.Token, result.Expiry, nil
+}
+
+// ReadTokenFile returns the token stored in the file at the given path.
+func ReadTokenFile(path string) (string, error) {
+	contents, err := os.ReadFile(path) // #nosec G304 -- path is user-configured
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(contents))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", path)
+	}
+
+	return token, nil
+}
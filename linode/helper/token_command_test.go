@@ -0,0 +1,113 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func TestRunTokenCommand(t *testing.T) {
+	token, expiry, err := helper.RunTokenCommand(context.Background(), []string{"echo", "plain-token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if token != "plain-token" || expiry != nil {
+		t.Errorf("expected plain-token without expiry, got %q, %v", token, expiry)
+	}
+
+	token, expiry, err = helper.RunTokenCommand(
+		context.Background(),
+		[]string{"echo", `{"token": "json-token", "expiry": "2030-01-02T03:04:05Z"}`},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedExpiry := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	if token != "json-token" || expiry == nil || !expiry.Equal(expectedExpiry) {
+		t.Errorf("expected json-token expiring at %s, got %q, %v", expectedExpiry, token, expiry)
+	}
+
+	if _, _, err := helper.RunTokenCommand(context.Background(), []string{"false"}); err == nil {
+		t.Error("expected an error for a failing command")
+	}
+}
+
+func TestTokenCommandTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if auth := r.Header.Get("Authorization"); auth != "Bearer command-token" {
+			t.Errorf("expected the token printed by the command, got %q", auth)
+		}
+
+		fmt.Fprint(w, `{"euuid": "account-euuid"}`)
+	}))
+	defer server.Close()
+
+	// The command counts how many times it has been run
+	counterPath := filepath.Join(t.TempDir(), "count")
+
+	// The token expires within the refresh window,
+	// so the command should be run for every request.
+	expiry := time.Now().UTC().Add(30 * time.Second).Format(time.RFC3339)
+
+	config := &helper.Config{
+		APIURL:               server.URL,
+		DisableInternalCache: true,
+		TokenCommand: []string{
+			"sh", "-c",
+			fmt.Sprintf(
+				`echo run >> %q; echo '{"token": "command-token", "expiry": "%s"}'`,
+				counterPath, expiry,
+			),
+		},
+	}
+
+	client, err := config.Client(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetAccount(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	runs, err := os.ReadFile(counterPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(runs) != "run\nrun\n" {
+		t.Errorf("expected the token command to run twice, got %q", runs)
+	}
+}
+
+func TestReadTokenFile(t *testing.T) {
+	tokenPath := filepath.Join(t.TempDir(), "token")
+
+	if err := os.WriteFile(tokenPath, []byte("file-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := helper.ReadTokenFile(tokenPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if token != "file-token" {
+		t.Errorf("expected file-token, got %q", token)
+	}
+}
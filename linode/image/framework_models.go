@@ -34,6 +34,7 @@ type ResourceModel struct {
 	Vendor              types.String      `tfsdk:"vendor"`
 	Timeouts            timeouts.Value    `tfsdk:"timeouts"`
 	Tags                types.List        `tfsdk:"tags"`
+	TagsAll             types.Set         `tfsdk:"tags_all"`
 	TotalSize           types.Int64       `tfsdk:"total_size"`
 	ReplicaRegions      types.List        `tfsdk:"replica_regions"`
 	Replications        types.List        `tfsdk:"replications"`
@@ -43,6 +44,7 @@ type ResourceModel struct {
 func (data *ResourceModel) FlattenImage(
 	ctx context.Context,
 	image *linodego.Image,
+	providerTags helper.ProviderTags,
 	preserveKnown bool,
 	diags *diag.Diagnostics,
 ) {
@@ -77,13 +79,20 @@ func (data *ResourceModel) FlattenImage(
 	data.Vendor = helper.KeepOrUpdateString(data.Vendor, image.Vendor, preserveKnown)
 	data.TotalSize = helper.KeepOrUpdateInt64(data.TotalSize, int64(image.TotalSize), preserveKnown)
 
-	tags, newDiags := types.ListValue(types.StringType, helper.StringSliceToFrameworkValueSlice(image.Tags))
+	tagsSlice, tagsAll, newDiags := providerTags.FrameworkFlattenTags(ctx, image.Tags, data.Tags)
+	diags.Append(newDiags...)
+	if diags.HasError() {
+		return
+	}
+
+	tags, newDiags := types.ListValue(types.StringType, helper.StringSliceToFrameworkValueSlice(tagsSlice))
 	diags.Append(newDiags...)
 	if diags.HasError() {
 		return
 	}
 
 	data.Tags = helper.KeepOrUpdateValue(data.Tags, tags, preserveKnown)
+	data.TagsAll = helper.KeepOrUpdateValue(data.TagsAll, tagsAll, preserveKnown)
 
 	replications, newDiags := flattenReplications(ctx, image.Regions)
 	diags.Append(newDiags...)
@@ -116,6 +125,7 @@ func (data *ResourceModel) CopyFrom(other ResourceModel, preserveKnown bool) {
 	data.Vendor = helper.KeepOrUpdateValue(data.Vendor, other.Vendor, preserveKnown)
 	data.Timeouts = helper.KeepOrUpdateValue(data.Timeouts, other.Timeouts, preserveKnown)
 	data.Tags = helper.KeepOrUpdateValue(data.Tags, other.Tags, preserveKnown)
+	data.TagsAll = helper.KeepOrUpdateValue(data.TagsAll, other.TagsAll, preserveKnown)
 	data.TotalSize = helper.KeepOrUpdateValue(data.TotalSize, other.TotalSize, preserveKnown)
 	data.ReplicaRegions = helper.KeepOrUpdateValue(data.ReplicaRegions, other.ReplicaRegions, preserveKnown)
 	data.Replications = helper.KeepOrUpdateValue(data.Replications, other.Replications, preserveKnown)
@@ -28,9 +28,10 @@ func NewResource() resource.Resource {
 	return &Resource{
 		BaseResource: helper.NewBaseResource(
 			helper.BaseResourceConfig{
-				Name:   "linode_image",
-				IDType: types.StringType,
-				Schema: &frameworkResourceSchema,
+				Name:        "linode_image",
+				IDType:      types.StringType,
+				Schema:      &frameworkResourceSchema,
+				ImportLabel: &importLabelConfig,
 				TimeoutOpts: &timeouts.Opts{
 					Create: true,
 				},
@@ -43,8 +44,35 @@ type Resource struct {
 	helper.BaseResource
 }
 
+var importLabelConfig = helper.ImportLabelConfig{
+	Lookup: func(ctx context.Context, client *linodego.Client, filter string) ([]string, error) {
+		images, err := client.ListImages(ctx, linodego.NewListOptions(0, filter))
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]string, 0, len(images))
+		for _, image := range images {
+			// Only private images can be managed by this resource
+			if image.IsPublic {
+				continue
+			}
+
+			result = append(result, image.ID)
+		}
+
+		return result, nil
+	},
+}
+
 func createResourceFromUpload(
-	ctx context.Context, plan *ResourceModel, client *linodego.Client, resp *resource.CreateResponse, timeoutSeconds int,
+	ctx context.Context,
+	plan *ResourceModel,
+	client *linodego.Client,
+	providerTags helper.ProviderTags,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+	timeoutSeconds int,
 ) *linodego.Image {
 	tflog.Debug(ctx, "Create linode_image from file uploading")
 
@@ -66,11 +94,18 @@ func createResourceFromUpload(
 		CloudInit:   plan.CloudInit.ValueBool(),
 	}
 
-	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &createOpts.Tags, true)...)
+	var tags []string
+
+	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, true)...)
 	if resp.Diagnostics.HasError() {
 		return nil
 	}
 
+	if tags != nil || len(providerTags.DefaultTags) > 0 {
+		mergedTags := providerTags.MergeTags(tags)
+		createOpts.Tags = &mergedTags
+	}
+
 	tflog.Trace(ctx, "client.CreateImageUpload(...)", map[string]any{
 		"options": createOpts,
 	})
@@ -80,12 +115,13 @@ func createResourceFromUpload(
 		addImageResource(ctx, resp, image.ID)
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helper.FrameworkAddAPIError(
+			ctx, &resp.Diagnostics, req.Plan,
 			fmt.Sprintf(
 				"Failed to Create Image (%s) from File Uploading",
 				plan.Label.ValueString(),
 			),
-			err.Error(),
+			err,
 		)
 		return image
 	}
@@ -106,7 +142,13 @@ func createResourceFromUpload(
 }
 
 func createResourceFromLinode(
-	ctx context.Context, plan *ResourceModel, client *linodego.Client, resp *resource.CreateResponse, timeoutSeconds int,
+	ctx context.Context,
+	plan *ResourceModel,
+	client *linodego.Client,
+	providerTags helper.ProviderTags,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+	timeoutSeconds int,
 ) *linodego.Image {
 	tflog.Debug(ctx, "Create linode_image from a Linode instance")
 
@@ -136,11 +178,18 @@ func createResourceFromLinode(
 		CloudInit:   plan.CloudInit.ValueBool(),
 	}
 
-	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &createOpts.Tags, true)...)
+	var tags []string
+
+	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, true)...)
 	if resp.Diagnostics.HasError() {
 		return nil
 	}
 
+	if tags != nil || len(providerTags.DefaultTags) > 0 {
+		mergedTags := providerTags.MergeTags(tags)
+		createOpts.Tags = &mergedTags
+	}
+
 	tflog.Trace(ctx, "client.CreateImage(...)", map[string]any{
 		"options": createOpts,
 	})
@@ -150,7 +199,7 @@ func createResourceFromLinode(
 		addImageResource(ctx, resp, image.ID)
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating a Linode Image", err.Error())
+		helper.FrameworkAddAPIError(ctx, &resp.Diagnostics, req.Plan, "Error creating a Linode Image", err)
 		return image
 	}
 
@@ -206,11 +255,13 @@ func (r *Resource) Create(
 		return
 	}
 
+	providerTags := r.Meta.Config.GetProviderTags(ctx)
+
 	var image *linodego.Image
 	if !plan.LinodeID.IsNull() && plan.FilePath.IsNull() {
-		image = createResourceFromLinode(ctx, &plan, client, resp, timeoutSeconds)
+		image = createResourceFromLinode(ctx, &plan, client, providerTags, req, resp, timeoutSeconds)
 	} else {
-		image = createResourceFromUpload(ctx, &plan, client, resp, timeoutSeconds)
+		image = createResourceFromUpload(ctx, &plan, client, providerTags, req, resp, timeoutSeconds)
 	}
 
 	if resp.Diagnostics.HasError() {
@@ -234,7 +285,7 @@ func (r *Resource) Create(
 		}
 	}
 
-	plan.FlattenImage(ctx, image, true, &resp.Diagnostics)
+	plan.FlattenImage(ctx, image, providerTags, true, &resp.Diagnostics)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -285,7 +336,7 @@ func (r *Resource) Read(
 		return
 	}
 
-	state.FlattenImage(ctx, image, true, &resp.Diagnostics)
+	state.FlattenImage(ctx, image, r.Meta.Config.GetProviderTags(ctx), true, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -293,6 +344,12 @@ func (r *Resource) Read(
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (r *Resource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	helper.FrameworkModifyPlanTagsAll(ctx, r.Meta, req, resp)
+}
+
 func (r *Resource) Update(
 	ctx context.Context,
 	req resource.UpdateRequest,
@@ -326,11 +383,29 @@ func (r *Resource) Update(
 		shouldUpdate = true
 	}
 
-	if !state.Tags.Equal(plan.Tags) {
-		resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &updateOpts.Tags, true)...)
+	providerTags := r.Meta.Config.GetProviderTags(ctx)
+
+	if !state.Tags.Equal(plan.Tags) || !state.TagsAll.Equal(plan.TagsAll) {
+		var tags []string
+
+		resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, true)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+
+		// Ignored tags must be preserved when updating the tags
+		var remoteTags []string
+		if providerTags.HasIgnoreTags() {
+			image, err := client.GetImage(ctx, imageID)
+			if err != nil {
+				resp.Diagnostics.AddError("Failed to Get Image", err.Error())
+				return
+			}
+			remoteTags = image.Tags
+		}
+
+		tags = providerTags.UpdateTags(tags, remoteTags)
+		updateOpts.Tags = &tags
 		shouldUpdate = true
 	}
 
@@ -341,10 +416,14 @@ func (r *Resource) Update(
 
 		image, err := client.UpdateImage(ctx, imageID, updateOpts)
 		if err != nil {
-			resp.Diagnostics.AddError("Failed to Update Image", err.Error())
+			helper.FrameworkAddAPIError(
+				ctx, &resp.Diagnostics, req.Plan,
+				"Failed to Update Image",
+				err,
+			)
 			return
 		}
-		plan.FlattenImage(ctx, image, true, &resp.Diagnostics)
+		plan.FlattenImage(ctx, image, providerTags, true, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -389,7 +468,7 @@ func (r *Resource) Update(
 		}
 
 		// Refresh image from replication
-		plan.FlattenImage(ctx, image, true, &resp.Diagnostics)
+		plan.FlattenImage(ctx, image, providerTags, true, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -200,6 +200,11 @@ var frameworkResourceSchema = schema.Schema{
 			Optional:    true,
 			ElementType: types.StringType,
 		},
+		"tags_all": schema.SetAttribute{
+			Description: "All tags applied to this object, including those inherited from the provider's default_tags.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
 		"total_size": schema.Int64Attribute{
 			Description: "The total size of the image in all available regions.",
 			Computed:    true,
@@ -414,6 +414,7 @@ func makeVolumeDetacherIgnoreAttached(client linodego.Client, d *schema.Resource
 func createInstanceDisk(
 	ctx context.Context,
 	client linodego.Client,
+	watcher *helper.EventWatcher,
 	instance linodego.Instance,
 	disk diskSpec,
 	d *schema.ResourceData,
@@ -479,7 +480,11 @@ func createInstanceDisk(
 		"options": diskOpts,
 	})
 
-	p, err := client.NewEventPoller(ctx, instance.ID, linodego.EntityLinode, linodego.ActionDiskCreate)
+	p, err := helper.NewEventWaiter(ctx, watcher, &client, helper.EventFilter{
+		EntityType: linodego.EntityLinode,
+		EntityID:   instance.ID,
+		Action:     linodego.ActionDiskCreate,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize event poller: %s", err)
 	}
@@ -600,7 +605,11 @@ func getInstanceDiskSpecDiffs(
 // This function will also warn when there are disks attached to an instance which are not managed by
 // terraform.
 func updateInstanceDisks(
-	ctx context.Context, client linodego.Client, d *schema.ResourceData, instance linodego.Instance,
+	ctx context.Context,
+	client linodego.Client,
+	watcher *helper.EventWatcher,
+	d *schema.ResourceData,
+	instance linodego.Instance,
 ) (bool, error) {
 	oldDisk, newDisk := getInstanceDiskSpecChange(d)
 	added, removed, existing := getInstanceDiskSpecDiffs(oldDisk, newDisk)
@@ -632,7 +641,11 @@ func updateInstanceDisks(
 
 		tflog.Info(ctx, "Deleting unused disk")
 
-		p, err := client.NewEventPoller(ctx, instance.ID, linodego.EntityLinode, linodego.ActionDiskDelete)
+		p, err := helper.NewEventWaiter(ctx, watcher, &client, helper.EventFilter{
+			EntityType: linodego.EntityLinode,
+			EntityID:   instance.ID,
+			Action:     linodego.ActionDiskDelete,
+		})
 		if err != nil {
 			return false, fmt.Errorf("failed to initialize event poller: %s", err)
 		}
@@ -661,7 +674,7 @@ func updateInstanceDisks(
 		// The only non-destructive change supported is resize.
 		// Label renames are not supported because this TF provider relies on the label as an identifier.
 		if spec["size"].(int) != existingDisk.Size {
-			if err := changeInstanceDiskSize(ctx, &client, instance, existingDisk, spec["size"].(int), d); err != nil {
+			if err := changeInstanceDiskSize(ctx, &client, watcher, instance, existingDisk, spec["size"].(int), d); err != nil {
 				return hasChanges, err
 			}
 			hasChanges = true
@@ -674,7 +687,7 @@ func updateInstanceDisks(
 
 	// create disks staged for creation
 	for _, spec := range added {
-		if _, err := createInstanceDisk(ctx, client, instance, spec, d); err != nil {
+		if _, err := createInstanceDisk(ctx, client, watcher, instance, spec, d); err != nil {
 			return hasChanges, err
 		}
 	}
@@ -828,6 +841,7 @@ func getDiskSizeChange(oldDisk interface{}, newDisk interface{}) (int, int) {
 func changeInstanceDiskSize(
 	ctx context.Context,
 	client *linodego.Client,
+	watcher *helper.EventWatcher,
 	instance linodego.Instance,
 	disk linodego.InstanceDisk,
 	targetSize int,
@@ -866,7 +880,11 @@ func changeInstanceDiskSize(
 
 	tflog.Info(ctx, "Instance has reached offline status, resizing disk")
 
-	p, err := client.NewEventPoller(ctx, instance.ID, linodego.EntityLinode, linodego.ActionDiskResize)
+	p, err := helper.NewEventWaiter(ctx, watcher, client, helper.EventFilter{
+		EntityType: linodego.EntityLinode,
+		EntityID:   instance.ID,
+		Action:     linodego.ActionDiskResize,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to initialize event poller: %s", err)
 	}
@@ -970,6 +988,7 @@ func applyInstanceDiskSpec(
 	ctx context.Context,
 	d *schema.ResourceData,
 	client *linodego.Client,
+	watcher *helper.EventWatcher,
 	instance *linodego.Instance,
 	typ *linodego.LinodeType,
 ) (bool, error) {
@@ -977,7 +996,7 @@ func applyInstanceDiskSpec(
 		return false, err
 	}
 
-	return updateInstanceDisks(ctx, *client, d, *instance)
+	return updateInstanceDisks(ctx, *client, watcher, d, *instance)
 }
 
 // assertDiskConfigFitsInstanceType asserts that the cumulative disk space used by a given disk config fits a given
@@ -1170,6 +1189,7 @@ func handleBootedUpdate(
 	ctx context.Context, d *schema.ResourceData, meta interface{}, instanceID, configID int,
 ) error {
 	client := meta.(*helper.ProviderMeta).Client
+	watcher := meta.(*helper.ProviderMeta).EventWatcher
 
 	deadlineSeconds := getDeadlineSeconds(ctx, d)
 
@@ -1192,13 +1212,13 @@ func handleBootedUpdate(
 
 	// Boot or shutdown the instance if necessary
 	if instStatus != linodego.InstanceRunning && booted.(bool) {
-		if err := BootInstanceSync(ctx, &client, instanceID, configID, deadlineSeconds); err != nil {
+		if err := BootInstanceSync(ctx, &client, watcher, instanceID, configID, deadlineSeconds); err != nil {
 			return err
 		}
 	}
 
 	if instStatus != linodego.InstanceOffline && !booted.(bool) {
-		if err := shutDownInstanceSync(ctx, client, instanceID, deadlineSeconds); err != nil {
+		if err := shutDownInstanceSync(ctx, client, watcher, instanceID, deadlineSeconds); err != nil {
 			return err
 		}
 	}
@@ -1250,10 +1270,19 @@ func waitForRunningOrOfflineState(
 	return instStatus, nil
 }
 
-func shutDownInstanceSync(ctx context.Context, client linodego.Client, instanceID, deadlineSeconds int) error {
+func shutDownInstanceSync(
+	ctx context.Context,
+	client linodego.Client,
+	watcher *helper.EventWatcher,
+	instanceID, deadlineSeconds int,
+) error {
 	tflog.Info(ctx, "Shutting down instance")
 
-	p, err := client.NewEventPoller(ctx, instanceID, linodego.EntityLinode, linodego.ActionLinodeShutdown)
+	p, err := helper.NewEventWaiter(ctx, watcher, &client, helper.EventFilter{
+		EntityType: linodego.EntityLinode,
+		EntityID:   instanceID,
+		Action:     linodego.ActionLinodeShutdown,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to initialize event poller: %s", err)
 	}
@@ -1275,12 +1304,21 @@ func shutDownInstanceSync(ctx context.Context, client linodego.Client, instanceI
 	return nil
 }
 
-func BootInstanceSync(ctx context.Context, client *linodego.Client, instanceID, configID, deadlineSeconds int) error {
+func BootInstanceSync(
+	ctx context.Context,
+	client *linodego.Client,
+	watcher *helper.EventWatcher,
+	instanceID, configID, deadlineSeconds int,
+) error {
 	ctx = tflog.SetField(ctx, "config_id", configID)
 
 	tflog.Info(ctx, "Booting instance")
 
-	p, err := client.NewEventPoller(ctx, instanceID, linodego.EntityLinode, linodego.ActionLinodeBoot)
+	p, err := helper.NewEventWaiter(ctx, watcher, client, helper.EventFilter{
+		EntityType: linodego.EntityLinode,
+		EntityID:   instanceID,
+		Action:     linodego.ActionLinodeBoot,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to initialize event poller: %s", err)
 	}
@@ -1432,14 +1470,20 @@ func VPCInterfaceIncluded(
 func BootInstanceAfterVPCInterfaceUpdate(ctx context.Context, meta *helper.ProviderMeta, instanceID, targetConfigID, deadlineSeconds int) diag.Diagnostics {
 	tflog.Debug(ctx, "Booting instance after VPC interface change applied")
 	if err := BootInstanceSync(
-		ctx, &meta.Client, instanceID, targetConfigID, deadlineSeconds,
+		ctx, &meta.Client, meta.EventWatcher, instanceID, targetConfigID, deadlineSeconds,
 	); err != nil {
 		return diag.Errorf("failed to boot instance after VPC interface change applied: %s", err)
 	}
 	return nil
 }
 
-func ShutdownInstanceForVPCInterfaceUpdate(ctx context.Context, client *linodego.Client, skipImplicitReboots bool, instanceID, deadlineSeconds int) error {
+func ShutdownInstanceForVPCInterfaceUpdate(
+	ctx context.Context,
+	client *linodego.Client,
+	watcher *helper.EventWatcher,
+	skipImplicitReboots bool,
+	instanceID, deadlineSeconds int,
+) error {
 	if skipImplicitReboots {
 		return fmt.Errorf(
 			"Adding, removing, and reordering a Linode VPC interface requires the implicit " +
@@ -1448,10 +1492,15 @@ func ShutdownInstanceForVPCInterfaceUpdate(ctx context.Context, client *linodego
 		)
 	}
 
-	return SafeShutdownInstance(ctx, client, instanceID, deadlineSeconds)
+	return SafeShutdownInstance(ctx, client, watcher, instanceID, deadlineSeconds)
 }
 
-func SafeShutdownInstance(ctx context.Context, client *linodego.Client, instanceID, deadlineSeconds int) error {
+func SafeShutdownInstance(
+	ctx context.Context,
+	client *linodego.Client,
+	watcher *helper.EventWatcher,
+	instanceID, deadlineSeconds int,
+) error {
 	instance, err := client.GetInstance(ctx, instanceID)
 	if err != nil {
 		return fmt.Errorf("failed to get instance %d: %s", instanceID, err)
@@ -1468,7 +1517,7 @@ func SafeShutdownInstance(ctx context.Context, client *linodego.Client, instance
 	}
 	if instance.Status != linodego.InstanceOffline {
 		if err := shutDownInstanceSync(
-			ctx, *client, instance.ID, deadlineSeconds,
+			ctx, *client, watcher, instance.ID, deadlineSeconds,
 		); err != nil {
 			return fmt.Errorf("failed to shutdown instance: %s", err)
 		}
@@ -1571,3 +1620,317 @@ func getPlacementGroupCreateOptions(ctx context.Context, d *schema.ResourceData)
 
 	return &pgOptions
 }
+
+// requiredRegionCapabilities returns the region capabilities
+// required by the planned configuration of an instance.
+func requiredRegionCapabilities(diff *schema.ResourceDiff) []string {
+	var result []string
+
+	hasVPCInterface := func(interfaces []any) bool {
+		for _, iface := range interfaces {
+			if iface, ok := iface.(map[string]any); ok && strings.EqualFold(iface["purpose"].(string), "vpc") {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	vpcInterface := hasVPCInterface(diff.Get("interface").([]any))
+	for _, config := range diff.Get("config").([]any) {
+		if config, ok := config.(map[string]any); ok && hasVPCInterface(config["interface"].([]any)) {
+			vpcInterface = true
+		}
+	}
+
+	if vpcInterface {
+		result = append(result, linodego.CapabilityVPCs)
+	}
+
+	if diff.Get("disk_encryption").(string) == string(linodego.InstanceDiskEncryptionEnabled) {
+		result = append(result, linodego.CapabilityDiskEncryption)
+	}
+
+	if diff.Get("placement_group.0.id").(int) != 0 {
+		result = append(result, linodego.CapabilityPlacementGroup)
+	}
+
+	return result
+}
+
+// plannedPrice returns the price of the planned type and region of an instance,
+// or nil if either is not yet known.
+func plannedPrice(
+	ctx context.Context,
+	diff *schema.ResourceDiff,
+	client *linodego.Client,
+) (*helper.Price, error) {
+	if !diff.NewValueKnown("type") || !diff.NewValueKnown("region") {
+		return nil, nil
+	}
+
+	typeID, region := diff.Get("type").(string), diff.Get("region").(string)
+	if typeID == "" || region == "" {
+		return nil, nil
+	}
+
+	price, err := helper.GetLinodeTypePrice(ctx, client, typeID, region, diff.Get("backups_enabled").(bool))
+	if err != nil {
+		return nil, err
+	}
+
+	return &price, nil
+}
+
+// rebuildAttributes are the attributes applied by rebuilding the instance
+// in place, see rebuild_on_image_change.
+var rebuildAttributes = []string{
+	"image",
+	"root_pass",
+	"authorized_keys",
+	"authorized_users",
+	"stackscript_id",
+	"stackscript_data",
+	"metadata.0.user_data",
+}
+
+// forceNewUnlessRebuilt forces the replacement of an instance when
+// rebuildAttributes change, unless the change is applied by rebuilding
+// the instance in place.
+func forceNewUnlessRebuilt(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	// An image unknown at plan time may resolve to an empty value, in which
+	// case shouldRebuildInstance would skip the rebuild during apply.
+	oldImage, newImage := diff.GetChange("image")
+	if diff.Get("rebuild_on_image_change").(bool) && diff.HasChange("image") &&
+		diff.NewValueKnown("image") && oldImage.(string) != "" && newImage.(string) != "" {
+		return nil
+	}
+
+	for _, key := range rebuildAttributes {
+		if !diff.HasChange(key) {
+			continue
+		}
+
+		if err := diff.ForceNew(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// shouldRebuildInstance returns whether the planned image change
+// should be applied by rebuilding the instance in place.
+func shouldRebuildInstance(d *schema.ResourceData) bool {
+	oldImage, newImage := d.GetChange("image")
+
+	return d.Get("rebuild_on_image_change").(bool) && d.HasChange("image") &&
+		oldImage.(string) != "" && newImage.(string) != ""
+}
+
+// getRawConfigStrings returns the elements of a list of strings in the raw config.
+// This is necessary for attributes only storing a hash in the state.
+func getRawConfigStrings(d *schema.ResourceData, key string) ([]string, error) {
+	value := d.GetRawConfig().GetAttr(key)
+	if value.IsNull() || !value.IsKnown() {
+		return nil, nil
+	}
+
+	result := make([]string, 0, value.LengthInt())
+
+	for it := value.ElementIterator(); it.Next(); {
+		_, elem := it.Element()
+		if elem.IsNull() {
+			return nil, fmt.Errorf("invalid input for %s: values cannot be empty or null", key)
+		}
+
+		result = append(result, elem.AsString())
+	}
+
+	return result, nil
+}
+
+// expandInstanceRebuildOptions returns the options to rebuild an instance
+// from its planned image.
+func expandInstanceRebuildOptions(d *schema.ResourceData) (linodego.InstanceRebuildOptions, error) {
+	var err error
+
+	opts := linodego.InstanceRebuildOptions{
+		Image:         d.Get("image").(string),
+		StackScriptID: d.Get("stackscript_id").(int),
+		// The instance is booted once its disks and config are updated
+		Booted: &boolFalse,
+	}
+
+	if opts.AuthorizedKeys, err = getRawConfigStrings(d, "authorized_keys"); err != nil {
+		return opts, err
+	}
+
+	if opts.AuthorizedUsers, err = getRawConfigStrings(d, "authorized_users"); err != nil {
+		return opts, err
+	}
+
+	if rootPass := d.GetRawConfig().GetAttr("root_pass"); !rootPass.IsNull() && rootPass.IsKnown() {
+		opts.RootPass = rootPass.AsString()
+	}
+
+	if opts.RootPass == "" {
+		if opts.RootPass, err = helper.CreateRandomRootPassword(); err != nil {
+			return opts, err
+		}
+	}
+
+	if stackscriptData, ok := d.GetOk("stackscript_data"); ok {
+		opts.StackScriptData = make(map[string]string, len(stackscriptData.(map[string]any)))
+		for name, value := range stackscriptData.(map[string]any) {
+			opts.StackScriptData[name] = value.(string)
+		}
+	}
+
+	if _, ok := d.GetOk("metadata.0"); ok {
+		opts.Metadata = &linodego.InstanceMetadataOptions{
+			UserData: d.Get("metadata.0.user_data").(string),
+		}
+	}
+
+	return opts, nil
+}
+
+// rebuildInstance rebuilds an instance with implicit disks from its planned image,
+// preserving its ID and IP addresses, and boots it unless booted is false.
+// It returns the rebuilt instance and the ID of its new config.
+func rebuildInstance(
+	ctx context.Context, d *schema.ResourceData, meta *helper.ProviderMeta, instance *linodego.Instance,
+) (*linodego.Instance, int, error) {
+	client := meta.Client
+
+	// Rebuilding deletes all disks of the instance
+	if err := validateImplicitDisks(ctx, &client, instance.ID); err != nil {
+		return nil, 0, err
+	}
+
+	opts, err := expandInstanceRebuildOptions(d)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	p, err := meta.NewEventWaiter(ctx, helper.EventFilter{
+		EntityType: linodego.EntityLinode,
+		EntityID:   instance.ID,
+		Action:     linodego.ActionLinodeRebuild,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to initialize event poller: %s", err)
+	}
+
+	tflog.Info(ctx, "Rebuilding instance", map[string]any{
+		"image": opts.Image,
+	})
+
+	tflog.Debug(ctx, "client.RebuildInstance(...)")
+
+	if _, err := client.RebuildInstance(ctx, instance.ID, opts); err != nil {
+		return nil, 0, fmt.Errorf("failed to rebuild instance: %s", err)
+	}
+
+	if _, err := p.WaitForFinished(ctx, getDeadlineSeconds(ctx, d)); err != nil {
+		return nil, 0, fmt.Errorf("failed to wait for instance rebuild: %s", err)
+	}
+
+	tflog.Debug(ctx, "Instance has finished rebuilding")
+
+	if instance, err = client.GetInstance(ctx, instance.ID); err != nil {
+		return nil, 0, fmt.Errorf("failed to get instance: %s", err)
+	}
+
+	configs, err := client.ListInstanceConfigs(ctx, instance.ID, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list instance configs: %s", err)
+	}
+
+	if len(configs) < 1 {
+		return nil, 0, fmt.Errorf("no config was created by rebuilding instance %d", instance.ID)
+	}
+
+	config := configs[0]
+
+	// The rebuilt config doesn't retain the interfaces of the previous one
+	if interfaces := d.Get("interface").([]any); len(interfaces) > 0 {
+		configUpdateOpts := linodego.InstanceConfigUpdateOptions{
+			Interfaces: helper.ExpandConfigInterfaces(ctx, interfaces),
+		}
+
+		tflog.Debug(ctx, "client.UpdateInstanceConfig(...)", map[string]any{
+			"options": configUpdateOpts,
+		})
+
+		if _, err := client.UpdateInstanceConfig(ctx, instance.ID, config.ID, configUpdateOpts); err != nil {
+			return nil, 0, fmt.Errorf("failed to set config interfaces: %s", err)
+		}
+	}
+
+	// The rebuilt disks use the default swap size
+	bootDisk, swapDisk, err := getInstanceDefaultDisks(ctx, instance.ID, &client)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if swapSize := d.Get("swap_size").(int); bootDisk != nil && swapDisk != nil &&
+		swapSize > 0 && swapSize != swapDisk.Size {
+		if err := resizeInstanceSwapDisk(
+			ctx, d, &client, meta.EventWatcher, instance, bootDisk, swapDisk, swapDisk.Size, swapSize,
+		); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	booted := d.Get("booted").(bool)
+	bootedNull := d.GetRawConfig().GetAttr("booted").IsNull()
+
+	targetStatus := linodego.InstanceOffline
+
+	if bootedNull || booted {
+		targetStatus = linodego.InstanceRunning
+
+		p, err := meta.NewEventWaiter(ctx, helper.EventFilter{
+			EntityType: linodego.EntityLinode,
+			EntityID:   instance.ID,
+			Action:     linodego.ActionLinodeBoot,
+		})
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to initialize event poller: %s", err)
+		}
+
+		tflog.Debug(ctx, "client.BootInstance(...)", map[string]any{
+			"config_id": config.ID,
+		})
+
+		if err := client.BootInstance(ctx, instance.ID, config.ID); err != nil {
+			return nil, 0, fmt.Errorf("failed to boot instance: %s", err)
+		}
+
+		if _, err := p.WaitForFinished(ctx, getDeadlineSeconds(ctx, d)); err != nil {
+			return nil, 0, fmt.Errorf("failed to wait for instance boot: %s", err)
+		}
+
+		tflog.Debug(ctx, "Instance has finished booting")
+	}
+
+	if !meta.Config.SkipInstanceReadyPoll {
+		tflog.Debug(ctx, "Waiting for instance to reach target status", map[string]any{
+			"target_status": targetStatus,
+		})
+
+		if instance, err = client.WaitForInstanceStatus(
+			ctx, instance.ID, targetStatus, getDeadlineSeconds(ctx, d),
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to wait for instance to reach status %s: %s", targetStatus, err)
+		}
+	}
+
+	return instance, config.ID, nil
+}
@@ -0,0 +1,325 @@
+//go:build unit
+
+package instance
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/fakeapi"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func newFakeProviderMeta(t *testing.T) (*fakeapi.Server, *helper.ProviderMeta) {
+	t.Helper()
+
+	server := fakeapi.New()
+	t.Cleanup(server.Close)
+
+	meta, err := server.ProviderMeta(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create provider meta: %s", err)
+	}
+
+	return server, meta
+}
+
+// testResourceDataWithRawConfig is similar to schema.TestResourceDataRaw,
+// but also populates the raw config checked by the create path.
+func testResourceDataWithRawConfig(t *testing.T, raw map[string]any) *schema.ResourceData {
+	t.Helper()
+
+	sm := schema.InternalMap(resourceSchema)
+
+	diff, err := sm.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rawJSON, err := json.Marshal(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff.RawConfig, err = ctyjson.Unmarshal(rawJSON, sm.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := sm.Data(nil, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return d
+}
+
+// testResourceDataUpdate returns the resource data and diff
+// to update the given instance to the given raw config.
+func testResourceDataUpdate(
+	t *testing.T, meta *helper.ProviderMeta, d *schema.ResourceData, raw map[string]any,
+) (*schema.ResourceData, *terraform.InstanceDiff) {
+	t.Helper()
+
+	sm := schema.InternalMap(resourceSchema)
+	state := d.State()
+
+	rawJSON, err := json.Marshal(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The raw config of the state is passed to the diff and its customizations
+	state.RawConfig, err = ctyjson.Unmarshal(rawJSON, sm.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := sm.Diff(
+		context.Background(), state, terraform.NewResourceConfigRaw(raw), Resource().CustomizeDiff, meta, true,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updated, err := sm.Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return updated, diff
+}
+
+func createFakeInstance(t *testing.T, meta *helper.ProviderMeta, raw map[string]any) *schema.ResourceData {
+	t.Helper()
+
+	d := testResourceDataWithRawConfig(t, raw)
+
+	if diags := createResource(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("failed to create instance: %v", diags)
+	}
+
+	return d
+}
+
+func TestResourceLifecycle_fakeAPI(t *testing.T) {
+	ctx := context.Background()
+	_, meta := newFakeProviderMeta(t)
+
+	d := createFakeInstance(t, meta, map[string]any{
+		"label":  "fake-instance",
+		"region": "us-east",
+		"type":   "g6-nanode-1",
+		"image":  "linode/alpine3.19",
+	})
+
+	if d.Get("status").(string) != string(linodego.InstanceRunning) {
+		t.Errorf("expected instance to be running, got %s", d.Get("status"))
+	}
+
+	if d.Get("ip_address").(string) == "" {
+		t.Error("expected ip_address to be populated")
+	}
+
+	if specsDisk := d.Get("specs.0.disk").(int); specsDisk != 25600 {
+		t.Errorf("expected specs.0.disk to be 25600, got %d", specsDisk)
+	}
+
+	if monthlyPrice := d.Get("monthly_price").(float64); monthlyPrice != 5 {
+		t.Errorf("expected monthly_price to be 5, got %v", monthlyPrice)
+	}
+
+	if hourlyPrice := d.Get("hourly_price").(float64); hourlyPrice != 0.0075 {
+		t.Errorf("expected hourly_price to be 0.0075, got %v", hourlyPrice)
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := shutDownInstanceSync(ctx, meta.Client, meta.EventWatcher, id, 5); err != nil {
+		t.Fatalf("failed to shut down instance: %s", err)
+	}
+
+	configs, err := meta.Client.ListInstanceConfigs(ctx, id, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := BootInstanceSync(ctx, &meta.Client, meta.EventWatcher, id, configs[0].ID, 5); err != nil {
+		t.Fatalf("failed to boot instance: %s", err)
+	}
+
+	if diags := deleteResource(ctx, d, meta); diags.HasError() {
+		t.Fatalf("failed to delete instance: %v", diags)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("expected ID to be cleared after delete, got %s", d.Id())
+	}
+}
+
+func TestReadResource_removedInstance_fakeAPI(t *testing.T) {
+	ctx := context.Background()
+	_, meta := newFakeProviderMeta(t)
+
+	d := createFakeInstance(t, meta, map[string]any{
+		"region": "us-east",
+		"type":   "g6-nanode-1",
+	})
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := meta.Client.DeleteInstance(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := readResource(ctx, d, meta); diags.HasError() {
+		t.Fatalf("failed to read instance: %v", diags)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("expected instance to be removed from state, got ID %s", d.Id())
+	}
+}
+
+func TestGetDiskSizeSum_fakeAPI(t *testing.T) {
+	ctx := context.Background()
+	_, meta := newFakeProviderMeta(t)
+
+	d := createFakeInstance(t, meta, map[string]any{
+		"region": "us-east",
+		"type":   "g6-standard-1",
+		"image":  "linode/alpine3.19",
+	})
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sum, err := getDiskSizeSum(ctx, d, &meta.Client, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if sum != 51200 {
+		t.Errorf("expected disks to fill the plan's 51200 MB, got %d", sum)
+	}
+
+	disk, err := getPrimaryImplicitDisk(ctx, d, &meta.Client, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if disk.Filesystem != linodego.FilesystemExt4 {
+		t.Errorf("expected the primary implicit disk to be ext4, got %s", disk.Filesystem)
+	}
+}
+
+func TestUpdateResource_rebuildOnImageChange_fakeAPI(t *testing.T) {
+	ctx := context.Background()
+	server, meta := newFakeProviderMeta(t)
+
+	config := map[string]any{
+		"label":                   "fake-instance",
+		"region":                  "us-east",
+		"type":                    "g6-standard-1",
+		"image":                   "linode/alpine3.19",
+		"swap_size":               256,
+		"rebuild_on_image_change": true,
+	}
+
+	d := createFakeInstance(t, meta, config)
+	id, ipAddress := d.Id(), d.Get("ip_address").(string)
+
+	config["image"] = "linode/debian12"
+	config["root_pass"] = "t3rr4f0rm-r3bu1ld!"
+	config["authorized_keys"] = []any{"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFake"}
+
+	d, diff := testResourceDataUpdate(t, meta, d, config)
+	if diff.RequiresNew() {
+		t.Fatal("expected the image change to be applied in place")
+	}
+
+	if diags := updateResource(ctx, d, meta); diags.HasError() {
+		t.Fatalf("failed to update instance: %v", diags)
+	}
+
+	if count := server.CountRequests("POST", "linode/instances/"+id+"/rebuild"); count != 1 {
+		t.Errorf("expected the instance to be rebuilt once, got %d rebuild requests", count)
+	}
+
+	if d.Id() != id {
+		t.Errorf("expected the instance ID to be preserved, got %s", d.Id())
+	}
+
+	if d.Get("ip_address").(string) != ipAddress {
+		t.Errorf("expected ip_address %s to be preserved, got %s", ipAddress, d.Get("ip_address"))
+	}
+
+	if d.Get("image").(string) != "linode/debian12" {
+		t.Errorf("expected the image to be linode/debian12, got %s", d.Get("image"))
+	}
+
+	if d.Get("status").(string) != string(linodego.InstanceRunning) {
+		t.Errorf("expected the rebuilt instance to be running, got %s", d.Get("status"))
+	}
+
+	instanceID, err := strconv.Atoi(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, swapDisk, err := getInstanceDefaultDisks(ctx, instanceID, &meta.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if swapDisk.Size != 256 {
+		t.Errorf("expected the rebuilt swap disk to keep swap_size 256, got %d", swapDisk.Size)
+	}
+}
+
+func TestForceNewUnlessRebuilt(t *testing.T) {
+	_, meta := newFakeProviderMeta(t)
+
+	config := map[string]any{
+		"region": "us-east",
+		"type":   "g6-nanode-1",
+		"image":  "linode/alpine3.19",
+	}
+
+	d := createFakeInstance(t, meta, config)
+
+	config["image"] = "linode/debian12"
+
+	if _, diff := testResourceDataUpdate(t, meta, d, config); !diff.RequiresNew() {
+		t.Error("expected an image change to replace the instance by default")
+	}
+
+	config["image"] = "linode/alpine3.19"
+	config["root_pass"] = "t3rr4f0rm-r3bu1ld!"
+	config["rebuild_on_image_change"] = true
+
+	if _, diff := testResourceDataUpdate(t, meta, d, config); !diff.RequiresNew() {
+		t.Error("expected a root_pass change without an image change to replace the instance")
+	}
+
+	// The placeholder used by the SDK for values unknown at plan time
+	config["image"] = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+	if _, diff := testResourceDataUpdate(t, meta, d, config); !diff.RequiresNew() {
+		t.Error("expected an image unknown at plan time to replace the instance")
+	}
+}
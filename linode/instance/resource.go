@@ -31,9 +31,16 @@ func Resource() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			linodediffs.ComputedWithDefault("tags", []string{}),
 			linodediffs.CaseInsensitiveSet("tags"),
+			linodediffs.TagsAll(),
+			linodediffs.RegionCapabilities(
+				requiredRegionCapabilities,
+				"interface", "config", "disk_encryption", "placement_group",
+			),
+			linodediffs.ComputedPrice(plannedPrice, "type", "region", "backups_enabled"),
+			forceNewUnlessRebuilt,
 		),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: helper.ImportStatePassthroughLabelContext("linode_instance", importLabelConfig),
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(LinodeInstanceCreateTimeout),
@@ -43,6 +50,23 @@ func Resource() *schema.Resource {
 	}
 }
 
+var importLabelConfig = helper.ImportLabelConfig{
+	SupportsRegion: true,
+	Lookup: func(ctx context.Context, client *linodego.Client, filter string) ([]string, error) {
+		instances, err := client.ListInstances(ctx, linodego.NewListOptions(0, filter))
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]string, 0, len(instances))
+		for _, instance := range instances {
+			result = append(result, strconv.Itoa(instance.ID))
+		}
+
+		return result, nil
+	},
+}
+
 func readResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ctx = populateLogAttributes(ctx, d)
 	tflog.Debug(ctx, "Read linode_instance")
@@ -111,7 +135,7 @@ func readResource(ctx context.Context, d *schema.ResourceData, meta interface{})
 	d.Set("region", instance.Region)
 	d.Set("watchdog_enabled", instance.WatchdogEnabled)
 	d.Set("group", instance.Group)
-	d.Set("tags", instance.Tags)
+	meta.(*helper.ProviderMeta).Config.GetProviderTags().SDKv2SetTags(d, instance.Tags)
 	d.Set("booted", isInstanceBooted(instance))
 	d.Set("host_uuid", instance.HostUUID)
 	d.Set("has_user_data", instance.HasUserData)
@@ -125,6 +149,20 @@ func readResource(ctx context.Context, d *schema.ResourceData, meta interface{})
 	d.Set("backups", flatBackups)
 	d.Set("backups_enabled", instance.Backups.Enabled)
 
+	// Types are memoized per provider instance, so the client held by
+	// the provider meta is used rather than the local copy.
+	price, err := helper.GetLinodeTypePrice(
+		ctx, &meta.(*helper.ProviderMeta).Client, instance.Type, instance.Region, instance.Backups.Enabled,
+	)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to get instance price", map[string]any{
+			"error": err.Error(),
+		})
+	} else {
+		d.Set("monthly_price", price.Monthly)
+		d.Set("hourly_price", price.Hourly)
+	}
+
 	d.Set("specs", flatSpecs)
 	d.Set("alerts", flatAlerts)
 
@@ -188,11 +226,9 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		),
 	}
 
-	if tagsRaw, tagsOk := d.GetOk("tags"); tagsOk {
-		for _, tag := range tagsRaw.(*schema.Set).List() {
-			createOpts.Tags = append(createOpts.Tags, tag.(string))
-		}
-	}
+	createOpts.Tags = meta.(*helper.ProviderMeta).Config.GetProviderTags().MergeTags(
+		helper.ExpandStringSet(d.Get("tags").(*schema.Set)),
+	)
 
 	if firewallID, ok := d.GetOk("firewall_id"); ok {
 		createOpts.FirewallID = firewallID.(int)
@@ -369,7 +405,9 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		for _, diskSpec := range diskSpecs {
 			diskSpec := diskSpec.(map[string]interface{})
 
-			instanceDisk, err := createInstanceDisk(ctx, client, *instance, diskSpec, d)
+			instanceDisk, err := createInstanceDisk(
+				ctx, client, meta.(*helper.ProviderMeta).EventWatcher, *instance, diskSpec, d,
+			)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -424,7 +462,11 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta interface{
 
 	if createOpts.Booted == nil || !*createOpts.Booted {
 		if disksOk && configsOk && (bootedNull || booted) {
-			p, err := client.NewEventPoller(ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeBoot)
+			p, err := meta.(*helper.ProviderMeta).NewEventWaiter(ctx, helper.EventFilter{
+				EntityType: linodego.EntityLinode,
+				EntityID:   instance.ID,
+				Action:     linodego.ActionLinodeBoot,
+			})
 			if err != nil {
 				return diag.Errorf("failed to initialize event poller: %s", err)
 			}
@@ -484,7 +526,11 @@ func findDiskByFS(disks []linodego.InstanceDisk, fs linodego.DiskFilesystem) *li
 //
 // returns bool describing whether the linode needs to be restarted.
 func adjustSwapSizeIfNeeded(
-	ctx context.Context, d *schema.ResourceData, client *linodego.Client, instance *linodego.Instance,
+	ctx context.Context,
+	d *schema.ResourceData,
+	client *linodego.Client,
+	watcher *helper.EventWatcher,
+	instance *linodego.Instance,
 ) (bool, error) {
 	if !d.HasChange("swap_size") {
 		return false, nil
@@ -498,7 +544,24 @@ func adjustSwapSizeIfNeeded(
 	}
 
 	oldSwapVal, newSwapVal := d.GetChange("swap_size")
-	oldSwap, newSwap := oldSwapVal.(int), newSwapVal.(int)
+	if err := resizeInstanceSwapDisk(
+		ctx, d, client, watcher, instance, bootDisk, swapDisk, oldSwapVal.(int), newSwapVal.(int),
+	); err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+// resizeInstanceSwapDisk resizes the swap disk of an instance with implicit disks
+// from oldSwap to newSwap, giving or taking the difference from the boot disk.
+func resizeInstanceSwapDisk(
+	ctx context.Context,
+	d *schema.ResourceData,
+	client *linodego.Client,
+	watcher *helper.EventWatcher,
+	instance *linodego.Instance,
+	bootDisk, swapDisk *linodego.InstanceDisk, oldSwap, newSwap int,
+) error {
 	diff := newSwap - oldSwap
 	newBootDiskSize := bootDisk.Size - diff
 
@@ -522,11 +585,11 @@ func adjustSwapSizeIfNeeded(
 	}
 
 	for _, resizeOp := range toResize {
-		if err := changeInstanceDiskSize(ctx, client, *instance, *resizeOp.disk, resizeOp.size, d); err != nil {
-			return true, err
+		if err := changeInstanceDiskSize(ctx, client, watcher, *instance, *resizeOp.disk, resizeOp.size, d); err != nil {
+			return err
 		}
 	}
-	return true, nil
+	return nil
 }
 
 func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -540,6 +603,12 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		return diag.Errorf("Error parsing Linode Instance ID %s as int: %s", d.Id(), err)
 	}
 
+	unlock, err := meta.(*helper.ProviderMeta).LockLinode(ctx, id)
+	if err != nil {
+		return diag.Errorf("failed to lock Linode instance %d: %s", id, err)
+	}
+	defer unlock()
+
 	if err := validateBooted(ctx, d); err != nil {
 		return diag.Errorf("failed to validate: %v", err)
 	}
@@ -561,8 +630,11 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		updateOpts.Group = &newGroup
 		simpleUpdate = true
 	}
-	if d.HasChange("tags") {
-		tags := helper.ExpandStringSet(d.Get("tags").(*schema.Set))
+	if d.HasChanges("tags", "tags_all") {
+		tags := meta.(*helper.ProviderMeta).Config.GetProviderTags().UpdateTags(
+			helper.ExpandStringSet(d.Get("tags").(*schema.Set)),
+			instance.Tags,
+		)
 		updateOpts.Tags = &tags
 		simpleUpdate = true
 	}
@@ -685,7 +757,9 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 
 	// We only need to do this if explicit disks are defined
 	if d.GetRawConfig().GetAttr("image").IsNull() {
-		if didChange, err := applyInstanceDiskSpec(ctx, d, &client, instance, newSpec); err == nil && didChange {
+		if didChange, err := applyInstanceDiskSpec(
+			ctx, d, &client, meta.(*helper.ProviderMeta).EventWatcher, instance, newSpec,
+		); err == nil && didChange {
 			rebootInstance = true
 		} else if err != nil && newSpec.Disk < oldSpec.Disk && !d.HasChange("disk") {
 			// Linode was downsized but the pre-existing disk config does not fit new instance spec
@@ -703,42 +777,63 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if didChange, err := adjustSwapSizeIfNeeded(ctx, d, &client, instance); err != nil {
+	rebuilt := shouldRebuildInstance(d)
+	rebuiltConfig := 0
+
+	if rebuilt {
+		// Rebuilding recreates the implicit disks with the planned swap size
+		instance, rebuiltConfig, err = rebuildInstance(ctx, d, meta.(*helper.ProviderMeta), instance)
+		if err != nil {
+			return diag.Errorf("failed to rebuild Linode instance %d: %s", id, err)
+		}
+	} else if didChange, err := adjustSwapSizeIfNeeded(
+		ctx, d, &client, meta.(*helper.ProviderMeta).EventWatcher, instance,
+	); err != nil {
 		return diag.FromErr(err)
 	} else if didChange {
 		rebootInstance = true
 	}
 
-	diskIDLabelMap, err := getInstanceDiskLabelIDMap(ctx, client, d, instance.ID)
-	if err != nil {
-		return diag.Errorf("failed to get disk label to ID mappings")
-	}
-
+	var diskIDLabelMap, updatedConfigMap map[string]int
 	bootConfig := 0
-	bootConfigLabel := d.Get("boot_config_label").(string)
 
-	tfConfigsOld, tfConfigsNew := d.GetChange("config")
-	didChangeConfig, updatedConfigMap, updatedConfigs, err := updateInstanceConfigs(
-		ctx, client, d, *instance, tfConfigsOld, tfConfigsNew, diskIDLabelMap, bootConfigLabel)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	rebootInstance = rebootInstance || didChangeConfig
+	if rebuilt {
+		// The disks and configs in the state were deleted by the rebuild,
+		// which already applied the planned interfaces and booted the instance.
+		bootConfig = rebuiltConfig
+		rebootInstance = false
+	} else {
+		diskIDLabelMap, err = getInstanceDiskLabelIDMap(ctx, client, d, instance.ID)
+		if err != nil {
+			return diag.Errorf("failed to get disk label to ID mappings")
+		}
 
-	if bootConfigLabel != "" {
-		if foundConfig, found := updatedConfigMap[bootConfigLabel]; found {
-			bootConfig = foundConfig
-		} else {
-			return diag.Errorf("Error setting boot_config_label: Config label '%s' not found", bootConfigLabel)
+		bootConfigLabel := d.Get("boot_config_label").(string)
+
+		tfConfigsOld, tfConfigsNew := d.GetChange("config")
+		didChangeConfig, configMap, updatedConfigs, err := updateInstanceConfigs(
+			ctx, client, d, *instance, tfConfigsOld, tfConfigsNew, diskIDLabelMap, bootConfigLabel)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		rebootInstance = rebootInstance || didChangeConfig
+		updatedConfigMap = configMap
+
+		if bootConfigLabel != "" {
+			if foundConfig, found := updatedConfigMap[bootConfigLabel]; found {
+				bootConfig = foundConfig
+			} else {
+				return diag.Errorf("Error setting boot_config_label: Config label '%s' not found", bootConfigLabel)
+			}
+		} else if len(updatedConfigs) > 0 {
+			bootConfig = updatedConfigs[0].ID
 		}
-	} else if len(updatedConfigs) > 0 {
-		bootConfig = updatedConfigs[0].ID
 	}
 
 	booted := d.Get("booted").(bool)
 	bootedNull := d.GetRawConfig().GetAttr("booted").IsNull()
 
-	if d.HasChange("interface") {
+	if d.HasChange("interface") && !rebuilt {
 		interfaces := d.Get("interface").([]interface{})
 
 		expandedInterfaces := helper.ExpandConfigInterfaces(ctx, interfaces)
@@ -766,7 +861,8 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 
 		if powerOffRequired {
 			if err := ShutdownInstanceForVPCInterfaceUpdate(
-				ctx, &client, skipImplicitReboots, id, helper.GetDeadlineSeconds(ctx, d),
+				ctx, &client, meta.(*helper.ProviderMeta).EventWatcher,
+				skipImplicitReboots, id, helper.GetDeadlineSeconds(ctx, d),
 			); err != nil {
 				return diag.FromErr(err)
 			}
@@ -833,7 +929,11 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 
 		tflog.Info(ctx, "Implicitly rebooting instance")
 
-		p, err := client.NewEventPoller(ctx, id, linodego.EntityLinode, linodego.ActionLinodeReboot)
+		p, err := meta.(*helper.ProviderMeta).NewEventWaiter(ctx, helper.EventFilter{
+			EntityType: linodego.EntityLinode,
+			EntityID:   id,
+			Action:     linodego.ActionLinodeReboot,
+		})
 		if err != nil {
 			return diag.Errorf("failed to initialize event poller: %s", err)
 		}
@@ -878,7 +978,17 @@ func deleteResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		return diag.Errorf("Error parsing Linode Instance ID %s as int", d.Id())
 	}
 
-	p, err := client.NewEventPoller(ctx, id, linodego.EntityLinode, linodego.ActionLinodeDelete)
+	unlock, err := meta.(*helper.ProviderMeta).LockLinode(ctx, id)
+	if err != nil {
+		return diag.Errorf("failed to lock Linode instance %d: %s", id, err)
+	}
+	defer unlock()
+
+	p, err := meta.(*helper.ProviderMeta).NewEventWaiter(ctx, helper.EventFilter{
+		EntityType: linodego.EntityLinode,
+		EntityID:   id,
+		Action:     linodego.ActionLinodeDelete,
+	})
 	if err != nil {
 		return diag.Errorf("failed to initialize event poller: %s", err)
 	}
@@ -72,7 +72,6 @@ func resourceMetadata() *schema.Resource {
 				Description: "The base64-encoded user-defined data exposed to this instance " +
 					"through the Linode Metadata service. Refer to the base64encode(...) function " +
 					"for information on encoding content for this field.",
-				ForceNew: true,
 			},
 		},
 	}
@@ -213,9 +212,16 @@ var resourceSchema = map[string]*schema.Schema{
 			"while your Images start with private/. See /images for more information on the Images available " +
 			"for you to use.",
 		Optional:      true,
-		ForceNew:      true,
 		ConflictsWith: []string{"disk", "config", "backup_id"},
 	},
+	"rebuild_on_image_change": {
+		Type: schema.TypeBool,
+		Description: "If true, changes to the image rebuild the Linode in place, preserving its ID and IP addresses, " +
+			"rather than replacing it. The root_pass, authorized_keys, authorized_users, stackscript_id, " +
+			"stackscript_data and metadata are applied to the rebuilt Linode.",
+		Optional: true,
+		Default:  false,
+	},
 	"backup_id": {
 		Type: schema.TypeInt,
 		Description: "A Backup ID from another Linode's available backups. Your User must have read_write " +
@@ -231,7 +237,6 @@ var resourceSchema = map[string]*schema.Schema{
 		Description: "The StackScript to deploy to the newly created Linode. If provided, 'image' must also be " +
 			"provided, and must be an Image that is compatible with this StackScript.",
 		Optional:      true,
-		ForceNew:      true,
 		RequiredWith:  []string{"image"},
 		ConflictsWith: []string{"disk", "config"},
 	},
@@ -241,7 +246,6 @@ var resourceSchema = map[string]*schema.Schema{
 			"being deployed to this Linode. Only accepted if 'stackscript_id' is given. The required values depend " +
 			"on the StackScript being deployed.",
 		Optional:      true,
-		ForceNew:      true,
 		Sensitive:     true,
 		RequiredWith:  []string{"image"},
 		ConflictsWith: []string{"disk", "config"},
@@ -267,6 +271,12 @@ var resourceSchema = map[string]*schema.Schema{
 		Description: "An array of tags applied to this object. Tags are for organizational purposes only.",
 		Computed:    true,
 	},
+	"tags_all": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+		Description: "All tags applied to this object, including those inherited from the provider's default_tags.",
+	},
 	"boot_config_label": {
 		Type:        schema.TypeString,
 		Description: "The Label of the Instance Config that should be used to boot the Linode instance.",
@@ -348,7 +358,6 @@ var resourceSchema = map[string]*schema.Schema{
 		Description: "A list of SSH public keys to deploy for the root user on the newly created Linode. " +
 			"Only accepted if 'image' is provided.",
 		Optional:      true,
-		ForceNew:      true,
 		StateFunc:     sshKeyState,
 		RequiredWith:  []string{"image"},
 		ConflictsWith: []string{"disk", "config"},
@@ -360,7 +369,6 @@ var resourceSchema = map[string]*schema.Schema{
 			"be appended to the `root` user's `~/.ssh/authorized_keys` file automatically. Only accepted if " +
 			"'image' is provided.",
 		Optional:      true,
-		ForceNew:      true,
 		StateFunc:     sshKeyState,
 		RequiredWith:  []string{"image"},
 		ConflictsWith: []string{"disk", "config"},
@@ -370,7 +378,6 @@ var resourceSchema = map[string]*schema.Schema{
 		Description: "The password that will be initially assigned to the 'root' user account.",
 		Sensitive:   true,
 		Optional:    true,
-		ForceNew:    true,
 		StateFunc:   rootPasswordState,
 		ValidateFunc: validation.StringLenBetween(
 			helper.RootPassMinimumCharacters,
@@ -493,6 +500,18 @@ var resourceSchema = map[string]*schema.Schema{
 		Description: "If applicable, the ID of the LKE cluster this Instance is a node of.",
 		Computed:    true,
 	},
+	"monthly_price": {
+		Type: schema.TypeFloat,
+		Description: "The monthly price of this Instance in US dollars, taking region-specific pricing " +
+			"into account and including the Backup service if enabled.",
+		Computed: true,
+	},
+	"hourly_price": {
+		Type: schema.TypeFloat,
+		Description: "The hourly price of this Instance in US dollars, taking region-specific pricing " +
+			"into account and including the Backup service if enabled.",
+		Computed: true,
+	},
 	"specs": {
 		Computed:    true,
 		Description: "Information about the resources available to this Linode.",
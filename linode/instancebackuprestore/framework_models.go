@@ -0,0 +1,26 @@
+package instancebackuprestore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+type ResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	LinodeID       types.Int64    `tfsdk:"linode_id"`
+	BackupID       types.Int64    `tfsdk:"backup_id"`
+	TargetLinodeID types.Int64    `tfsdk:"target_linode_id"`
+	Overwrite      types.Bool     `tfsdk:"overwrite"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// GetRestoreOptions returns the options to restore the backup onto the target Linode.
+func (data *ResourceModel) GetRestoreOptions(diags *diag.Diagnostics) linodego.RestoreInstanceOptions {
+	return linodego.RestoreInstanceOptions{
+		LinodeID:  helper.FrameworkSafeInt64ToInt(data.TargetLinodeID.ValueInt64(), diags),
+		Overwrite: data.Overwrite.ValueBool(),
+	}
+}
@@ -0,0 +1,28 @@
+//go:build unit
+
+package instancebackuprestore
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRestoreOptions(t *testing.T) {
+	var diags diag.Diagnostics
+
+	data := &ResourceModel{
+		LinodeID:       types.Int64Value(123),
+		BackupID:       types.Int64Value(456),
+		TargetLinodeID: types.Int64Value(789),
+		Overwrite:      types.BoolValue(true),
+	}
+
+	opts := data.GetRestoreOptions(&diags)
+
+	assert.False(t, diags.HasError(), "Expected no error")
+	assert.Equal(t, linodego.RestoreInstanceOptions{LinodeID: 789, Overwrite: true}, opts)
+}
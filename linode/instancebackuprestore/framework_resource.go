@@ -0,0 +1,264 @@
+package instancebackuprestore
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+const (
+	DefaultRestoreCreateTimeout = 30 * time.Minute
+	DefaultRestoreUpdateTimeout = 10 * time.Minute
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_instance_backup_restore",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+					Update: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, plan)
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, DefaultRestoreCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(createTimeout.Seconds(), &resp.Diagnostics)
+	linodeID := helper.FrameworkSafeInt64ToInt(plan.LinodeID.ValueInt64(), &resp.Diagnostics)
+	backupID := helper.FrameworkSafeInt64ToInt(plan.BackupID.ValueInt64(), &resp.Diagnostics)
+	restoreOpts := plan.GetRestoreOptions(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := r.Meta.LockLinode(ctx, restoreOpts.LinodeID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Lock Linode %d", restoreOpts.LinodeID), err.Error(),
+		)
+		return
+	}
+	defer unlock()
+
+	p, err := r.Meta.NewEventWaiter(ctx, helper.EventFilter{
+		EntityType: linodego.EntityLinode,
+		EntityID:   restoreOpts.LinodeID,
+		Action:     linodego.ActionBackupsRestore,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Initialize Event Poller", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "client.RestoreInstanceBackup(...)", map[string]any{
+		"options": restoreOpts,
+	})
+
+	if err := r.Meta.Client.RestoreInstanceBackup(ctx, linodeID, backupID, restoreOpts); err != nil {
+		helper.FrameworkAddAPIError(
+			ctx, &resp.Diagnostics, req.Plan,
+			fmt.Sprintf("Failed to Restore Backup %d of Linode Instance %d", backupID, linodeID),
+			err,
+		)
+		return
+	}
+
+	// Add resource to TF states earlier to prevent
+	// dangling resources (resources created but not managed by TF)
+	plan.ID = types.StringValue(strconv.Itoa(backupID))
+	resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)
+	resp.State.SetAttribute(ctx, path.Root("linode_id"), plan.LinodeID)
+	resp.State.SetAttribute(ctx, path.Root("backup_id"), plan.BackupID)
+	resp.State.SetAttribute(ctx, path.Root("target_linode_id"), plan.TargetLinodeID)
+	resp.State.SetAttribute(ctx, path.Root("overwrite"), plan.Overwrite)
+
+	if _, err := p.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf(
+				"Failed to Wait for Backup %d to be Restored onto Linode Instance %d",
+				backupID, restoreOpts.LinodeID,
+			),
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Restore event finished")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read only checks whether the target Linode still exists,
+// the restore itself is not tracked by the API.
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	targetID := helper.FrameworkSafeInt64ToInt(state.TargetLinodeID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.Meta.Client.GetInstance(ctx, targetID); err != nil {
+		if linodego.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Linode Instance Not Found",
+				fmt.Sprintf(
+					"Removing the restore onto Linode Instance %d from state because it no longer exists",
+					targetID,
+				),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Linode Instance %d", targetID), err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only applies the timeouts, all other attributes require replacement.
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the restore from the state,
+// the restored disks and configs are left on the target Linode.
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	tflog.Info(ctx, "Leaving the restored disks and configs on the target Linode Instance in place")
+}
+
+// ImportState imports a restore by the ID of the Linode the backup belongs to,
+// the backup ID and the ID of the target Linode. The restore is not tracked by
+// the API, so overwrite is left null and taken from the configuration.
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	tflog.Debug(ctx, "Import "+r.Config.Name)
+	helper.ImportStateWithMultipleIDs(
+		ctx, req, resp,
+		[]helper.ImportableID{
+			{
+				Name:          "linode_id",
+				TypeConverter: helper.IDTypeConverterInt64,
+			},
+			{
+				Name:          "backup_id",
+				TypeConverter: helper.IDTypeConverterInt64,
+			},
+			{
+				Name:          "target_linode_id",
+				TypeConverter: helper.IDTypeConverterInt64,
+			},
+		},
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var backupID types.Int64
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("backup_id"), &backupID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), types.StringValue(strconv.FormatInt(backupID.ValueInt64(), 10)),
+	)...)
+}
+
+func populateLogAttributes(ctx context.Context, model ResourceModel) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"linode_id":        model.LinodeID.ValueInt64(),
+		"backup_id":        model.BackupID.ValueInt64(),
+		"target_linode_id": model.TargetLinodeID.ValueInt64(),
+	})
+}
@@ -0,0 +1,66 @@
+package instancebackuprestore
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+var frameworkResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the restored backup.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"linode_id": schema.Int64Attribute{
+			Description: "The ID of the Linode the backup belongs to.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"backup_id": schema.Int64Attribute{
+			Description: "The ID of the backup to restore.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"target_linode_id": schema.Int64Attribute{
+			Description: "The ID of the Linode to restore the backup onto.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"overwrite": schema.BoolAttribute{
+			Description: "Whether to delete all disks and configs on the target Linode " +
+				"before restoring the backup.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+			PlanModifiers: []planmodifier.Bool{
+				// Imported restores don't know their overwrite value
+				boolplanmodifier.RequiresReplaceIf(
+					func(
+						ctx context.Context,
+						req planmodifier.BoolRequest,
+						resp *boolplanmodifier.RequiresReplaceIfFuncResponse,
+					) {
+						resp.RequiresReplace = !req.StateValue.IsNull()
+					},
+					"Changing overwrite requires replacement unless the restore was imported.",
+					"Changing `overwrite` requires replacement unless the restore was imported.",
+				),
+			},
+		},
+	},
+}
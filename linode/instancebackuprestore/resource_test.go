@@ -0,0 +1,82 @@
+//go:build integration || instancebackuprestore
+
+package instancebackuprestore_test
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/instancebackuprestore/tmpl"
+)
+
+const testRestoreResName = "linode_instance_backup_restore.foobar"
+
+var testRegion string
+
+func init() {
+	region, err := acceptance.GetRandomRegionWithCaps(nil, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccResourceInstanceBackupRestore_basic(t *testing.T) {
+	t.Parallel()
+
+	label := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, label, testRegion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						testRestoreResName, "id", "linode_instance_snapshot.source", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						testRestoreResName, "backup_id", "linode_instance_snapshot.source", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						testRestoreResName, "linode_id", "linode_instance.source", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						testRestoreResName, "target_linode_id", "linode_instance.target", "id",
+					),
+					resource.TestCheckResourceAttr(testRestoreResName, "overwrite", "true"),
+				),
+			},
+			{
+				ResourceName:            testRestoreResName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       resourceImportStateID,
+				ImportStateVerifyIgnore: []string{"overwrite"},
+			},
+		},
+	})
+}
+
+func resourceImportStateID(s *terraform.State) (string, error) {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_instance_backup_restore" {
+			continue
+		}
+
+		return fmt.Sprintf(
+			"%s,%s,%s",
+			rs.Primary.Attributes["linode_id"], rs.Primary.ID, rs.Primary.Attributes["target_linode_id"],
+		), nil
+	}
+
+	return "", fmt.Errorf("Error finding linode_instance_backup_restore")
+}
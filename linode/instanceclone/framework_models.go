@@ -0,0 +1,65 @@
+package instanceclone
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+type ResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	SourceLinodeID   types.Int64    `tfsdk:"source_linode_id"`
+	TargetLinodeID   types.Int64    `tfsdk:"target_linode_id"`
+	LinodeID         types.Int64    `tfsdk:"linode_id"`
+	Region           types.String   `tfsdk:"region"`
+	Type             types.String   `tfsdk:"type"`
+	Label            types.String   `tfsdk:"label"`
+	Disks            types.Set      `tfsdk:"disks"`
+	Configs          types.Set      `tfsdk:"configs"`
+	BackupsEnabled   types.Bool     `tfsdk:"backups_enabled"`
+	PrivateIP        types.Bool     `tfsdk:"private_ip"`
+	Status           types.String   `tfsdk:"status"`
+	IPv4             types.Set      `tfsdk:"ipv4"`
+	IPv6             types.String   `tfsdk:"ipv6"`
+	IPAddress        types.String   `tfsdk:"ip_address"`
+	PrivateIPAddress types.String   `tfsdk:"private_ip_address"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// FlattenInstance flattens the Linode the source was cloned into.
+func (data *ResourceModel) FlattenInstance(
+	instance *linodego.Instance, preserveKnown bool, diags *diag.Diagnostics,
+) {
+	data.ID = helper.KeepOrUpdateString(data.ID, strconv.Itoa(instance.ID), preserveKnown)
+	data.LinodeID = helper.KeepOrUpdateInt64(data.LinodeID, int64(instance.ID), preserveKnown)
+	data.Region = helper.KeepOrUpdateString(data.Region, instance.Region, preserveKnown)
+	data.Type = helper.KeepOrUpdateString(data.Type, instance.Type, preserveKnown)
+	data.Label = helper.KeepOrUpdateString(data.Label, instance.Label, preserveKnown)
+	data.BackupsEnabled = helper.KeepOrUpdateBool(
+		data.BackupsEnabled, instance.Backups != nil && instance.Backups.Enabled, preserveKnown,
+	)
+	data.Status = helper.KeepOrUpdateString(data.Status, string(instance.Status), preserveKnown)
+	data.IPv6 = helper.KeepOrUpdateString(data.IPv6, instance.IPv6, preserveKnown)
+
+	ipv4 := make([]string, len(instance.IPv4))
+	var ipAddress, privateIPAddress string
+
+	for i, ip := range instance.IPv4 {
+		ipv4[i] = ip.String()
+
+		if ip.IsPrivate() {
+			privateIPAddress = ip.String()
+		} else {
+			ipAddress = ip.String()
+		}
+	}
+
+	data.IPv4 = helper.KeepOrUpdateStringSet(data.IPv4, ipv4, preserveKnown, diags)
+	data.IPAddress = helper.KeepOrUpdateString(data.IPAddress, ipAddress, preserveKnown)
+	data.PrivateIPAddress = helper.KeepOrUpdateString(data.PrivateIPAddress, privateIPAddress, preserveKnown)
+	data.PrivateIP = helper.KeepOrUpdateBool(data.PrivateIP, privateIPAddress != "", preserveKnown)
+}
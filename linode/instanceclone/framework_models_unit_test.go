@@ -0,0 +1,77 @@
+//go:build unit
+
+package instanceclone
+
+import (
+	"net"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenInstance(t *testing.T) {
+	publicIP := net.ParseIP("203.0.113.10")
+	privateIP := net.ParseIP("192.168.128.10")
+
+	instance := &linodego.Instance{
+		ID:      123,
+		Label:   "staging-copy",
+		Region:  "us-east",
+		Type:    "g6-standard-1",
+		Status:  linodego.InstanceOffline,
+		IPv4:    []*net.IP{&publicIP, &privateIP},
+		IPv6:    "2001:db8::7b/128",
+		Backups: &linodego.InstanceBackup{Enabled: true},
+	}
+
+	var diags diag.Diagnostics
+
+	data := &ResourceModel{}
+	data.FlattenInstance(instance, false, &diags)
+
+	assert.False(t, diags.HasError(), "Expected no error")
+
+	assert.Equal(t, types.StringValue("123"), data.ID)
+	assert.Equal(t, types.Int64Value(123), data.LinodeID)
+	assert.Equal(t, types.StringValue("staging-copy"), data.Label)
+	assert.Equal(t, types.StringValue("us-east"), data.Region)
+	assert.Equal(t, types.StringValue("g6-standard-1"), data.Type)
+	assert.Equal(t, types.StringValue("offline"), data.Status)
+	assert.Equal(t, types.BoolValue(true), data.BackupsEnabled)
+	assert.Equal(t, types.StringValue("2001:db8::7b/128"), data.IPv6)
+	assert.Equal(t, types.StringValue("203.0.113.10"), data.IPAddress)
+	assert.Equal(t, types.StringValue("192.168.128.10"), data.PrivateIPAddress)
+	assert.Equal(t, types.BoolValue(true), data.PrivateIP)
+	assert.Len(t, data.IPv4.Elements(), 2)
+	assert.Contains(t, data.IPv4.String(), "203.0.113.10")
+	assert.Contains(t, data.IPv4.String(), "192.168.128.10")
+}
+
+func TestFlattenInstancePreserveKnown(t *testing.T) {
+	publicIP := net.ParseIP("203.0.113.10")
+
+	data := &ResourceModel{
+		Label:            types.StringValue("configured-label"),
+		IPAddress:        types.StringUnknown(),
+		PrivateIPAddress: types.StringUnknown(),
+		BackupsEnabled:   types.BoolUnknown(),
+	}
+
+	var diags diag.Diagnostics
+
+	data.FlattenInstance(&linodego.Instance{
+		ID:    123,
+		Label: "linode123",
+		IPv4:  []*net.IP{&publicIP},
+	}, true, &diags)
+
+	assert.False(t, diags.HasError(), "Expected no error")
+
+	assert.Equal(t, types.StringValue("configured-label"), data.Label)
+	assert.Equal(t, types.StringValue("203.0.113.10"), data.IPAddress)
+	assert.Equal(t, types.StringValue(""), data.PrivateIPAddress)
+	assert.Equal(t, types.BoolValue(false), data.BackupsEnabled)
+}
@@ -0,0 +1,376 @@
+package instanceclone
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+const (
+	DefaultCloneCreateTimeout = 30 * time.Minute
+	DefaultCloneUpdateTimeout = 10 * time.Minute
+	DefaultCloneDeleteTimeout = 10 * time.Minute
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_instance_clone",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+					Update: true,
+					Delete: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, plan)
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, DefaultCloneCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	client := r.Meta.Client
+
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(createTimeout.Seconds(), &resp.Diagnostics)
+	sourceID := helper.FrameworkSafeInt64ToInt(plan.SourceLinodeID.ValueInt64(), &resp.Diagnostics)
+	targetID := helper.FrameworkSafeInt64ToInt(plan.TargetLinodeID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloneOpts := linodego.InstanceCloneOptions{
+		Region:         plan.Region.ValueString(),
+		Type:           plan.Type.ValueString(),
+		LinodeID:       targetID,
+		Label:          plan.Label.ValueString(),
+		BackupsEnabled: plan.BackupsEnabled.ValueBool(),
+		PrivateIP:      plan.PrivateIP.ValueBool(),
+		Disks:          helper.ExpandFwInt64Set(plan.Disks, &resp.Diagnostics),
+		Configs:        helper.ExpandFwInt64Set(plan.Configs, &resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := r.lockLinodes(ctx, sourceID, targetID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Lock Linodes", err.Error())
+		return
+	}
+	defer unlock()
+
+	p, err := r.Meta.NewEventWaiter(ctx, helper.EventFilter{
+		EntityType: linodego.EntityLinode,
+		EntityID:   sourceID,
+		Action:     linodego.ActionLinodeClone,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Initialize Event Poller", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "client.CloneInstance(...)", map[string]any{
+		"options": cloneOpts,
+	})
+
+	instance, err := client.CloneInstance(ctx, sourceID, cloneOpts)
+	if err != nil {
+		helper.FrameworkAddAPIError(
+			ctx, &resp.Diagnostics, req.Plan,
+			fmt.Sprintf("Failed to Clone Linode Instance %d", sourceID),
+			err,
+		)
+		return
+	}
+
+	// Add resource to TF states earlier to prevent
+	// dangling resources (resources created but not managed by TF)
+	addCloneResource(ctx, instance, resp, plan)
+
+	ctx = tflog.SetField(ctx, "linode_id", instance.ID)
+
+	if _, err := p.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Wait for Linode Instance %d to Finish Cloning", sourceID),
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Clone event finished")
+
+	// New Linodes are provisioning until the cloned disks are ready
+	if plan.TargetLinodeID.IsNull() && !r.Meta.Config.SkipInstanceReadyPoll.ValueBool() {
+		if _, err := client.WaitForInstanceStatus(
+			ctx, instance.ID, linodego.InstanceOffline, timeoutSeconds,
+		); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to Wait for Linode Instance %d to be Ready", instance.ID),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "client.GetInstance(...)")
+
+	cloneID := instance.ID
+
+	instance, err = client.GetInstance(ctx, cloneID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Linode Instance %d", cloneID), err.Error(),
+		)
+		return
+	}
+
+	plan.FlattenInstance(instance, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// ImportState is not supported as the source Linode, disks and configs
+// of a clone can't be retrieved from the API.
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resp.Diagnostics.AddError(
+		"Import Not Supported",
+		fmt.Sprintf("%s resources can't be imported.", r.Config.Name),
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	id := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.Meta.Client.GetInstance(ctx, id)
+	if err != nil {
+		if linodego.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Linode Instance Not Found",
+				fmt.Sprintf(
+					"Removing the clone into Linode Instance %d from state because it no longer exists",
+					id,
+				),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Linode Instance %d", id), err.Error(),
+		)
+		return
+	}
+
+	state.FlattenInstance(instance, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only applies the timeouts, all other attributes require replacement.
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	// Existing target Linodes are not managed by this resource
+	if !state.TargetLinodeID.IsNull() {
+		tflog.Info(ctx, "Leaving the target Linode Instance of the clone in place")
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, DefaultCloneDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	id := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(deleteTimeout.Seconds(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := r.Meta.LockLinode(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to Lock Linode %d", id), err.Error())
+		return
+	}
+	defer unlock()
+
+	p, err := r.Meta.NewEventWaiter(ctx, helper.EventFilter{
+		EntityType: linodego.EntityLinode,
+		EntityID:   id,
+		Action:     linodego.ActionLinodeDelete,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Initialize Event Poller", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "client.DeleteInstance(...)")
+
+	if err := r.Meta.Client.DeleteInstance(ctx, id); err != nil {
+		if linodego.IsNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Delete Linode Instance %d", id), err.Error(),
+		)
+		return
+	}
+
+	if !r.Meta.Config.SkipInstanceDeletePoll.ValueBool() {
+		if _, err := p.WaitForFinished(ctx, timeoutSeconds); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to Wait for Linode Instance %d to be Deleted", id), err.Error(),
+			)
+		}
+	}
+}
+
+// lockLinodes locks the source and, if any, the target Linode of a clone
+// in ascending order of their IDs to avoid deadlocks between clones.
+// Duplicate IDs are locked once as the locks are not reentrant.
+func (r *Resource) lockLinodes(ctx context.Context, linodeIDs ...int) (func(), error) {
+	slices.Sort(linodeIDs)
+	linodeIDs = slices.Compact(linodeIDs)
+
+	var unlocks []func()
+
+	unlockAll := func() {
+		for i := len(unlocks) - 1; i >= 0; i-- {
+			unlocks[i]()
+		}
+	}
+
+	for _, id := range linodeIDs {
+		if id == 0 {
+			continue
+		}
+
+		unlock, err := r.Meta.LockLinode(ctx, id)
+		if err != nil {
+			unlockAll()
+			return nil, err
+		}
+
+		unlocks = append(unlocks, unlock)
+	}
+
+	return unlockAll, nil
+}
+
+func addCloneResource(
+	ctx context.Context, instance *linodego.Instance, resp *resource.CreateResponse, plan ResourceModel,
+) {
+	resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(strconv.Itoa(instance.ID)))
+	resp.State.SetAttribute(ctx, path.Root("linode_id"), types.Int64Value(int64(instance.ID)))
+	resp.State.SetAttribute(ctx, path.Root("source_linode_id"), plan.SourceLinodeID)
+	resp.State.SetAttribute(ctx, path.Root("target_linode_id"), plan.TargetLinodeID)
+}
+
+func populateLogAttributes(ctx context.Context, model ResourceModel) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"source_linode_id": model.SourceLinodeID.ValueInt64(),
+		"linode_id":        model.ID.ValueString(),
+	})
+}
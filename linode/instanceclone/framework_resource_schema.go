@@ -0,0 +1,160 @@
+package instanceclone
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var frameworkResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the Linode the source Linode was cloned into.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"source_linode_id": schema.Int64Attribute{
+			Description: "The ID of the Linode to clone.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"target_linode_id": schema.Int64Attribute{
+			Description: "The ID of an existing Linode to clone the source Linode into. " +
+				"If omitted, a new Linode is created in the given region with the given type.",
+			Optional: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+			Validators: []validator.Int64{
+				int64validator.ExactlyOneOf(path.MatchRoot("region")),
+			},
+		},
+		"linode_id": schema.Int64Attribute{
+			Description: "The ID of the Linode the source Linode was cloned into.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"region": schema.StringAttribute{
+			Description: "The region to create the new Linode in.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("type")),
+			},
+		},
+		"type": schema.StringAttribute{
+			Description: "The Linode type of the new Linode.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("region")),
+			},
+		},
+		"label": schema.StringAttribute{
+			Description: "The label of the new Linode.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("target_linode_id")),
+			},
+		},
+		"disks": schema.SetAttribute{
+			Description: "The IDs of the source Linode's disks to clone. " +
+				"If omitted, all disks are cloned.",
+			ElementType: types.Int64Type,
+			Optional:    true,
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.RequiresReplace(),
+			},
+		},
+		"configs": schema.SetAttribute{
+			Description: "The IDs of the source Linode's configs to clone. " +
+				"If omitted, all configs are cloned.",
+			ElementType: types.Int64Type,
+			Optional:    true,
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.RequiresReplace(),
+			},
+		},
+		"backups_enabled": schema.BoolAttribute{
+			Description: "Whether to enroll the new Linode in the Linode Backup service.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplaceIfConfigured(),
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"private_ip": schema.BoolAttribute{
+			Description: "Whether to assign a private IPv4 address to the new Linode.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplaceIfConfigured(),
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"status": schema.StringAttribute{
+			Description: "The status of the Linode the source Linode was cloned into.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ipv4": schema.SetAttribute{
+			Description: "The IPv4 addresses of the Linode the source Linode was cloned into.",
+			ElementType: types.StringType,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ipv6": schema.StringAttribute{
+			Description: "The IPv6 SLAAC address of the Linode the source Linode was cloned into.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ip_address": schema.StringAttribute{
+			Description: "The public IPv4 address of the Linode the source Linode was cloned into.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"private_ip_address": schema.StringAttribute{
+			Description: "The private IPv4 address of the Linode the source Linode was cloned into, if any.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
@@ -0,0 +1,85 @@
+//go:build integration || instanceclone
+
+package instanceclone_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/instanceclone/tmpl"
+)
+
+const testCloneResName = "linode_instance_clone.foobar"
+
+var testRegion string
+
+func init() {
+	region, err := acceptance.GetRandomRegionWithCaps(nil, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccResourceInstanceClone_basic(t *testing.T) {
+	t.Parallel()
+
+	var instance linodego.Instance
+
+	label := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, label, testRegion),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckInstanceExists(testCloneResName, &instance),
+					resource.TestCheckResourceAttrSet(testCloneResName, "linode_id"),
+					resource.TestCheckResourceAttr(testCloneResName, "label", label+"-clone"),
+					resource.TestCheckResourceAttr(testCloneResName, "region", testRegion),
+					resource.TestCheckResourceAttr(testCloneResName, "type", "g6-nanode-1"),
+					resource.TestCheckResourceAttr(testCloneResName, "status", "offline"),
+					resource.TestCheckResourceAttr(testCloneResName, "ipv4.#", "2"),
+					resource.TestCheckResourceAttrSet(testCloneResName, "ip_address"),
+					resource.TestCheckResourceAttrSet(testCloneResName, "private_ip_address"),
+					resource.TestCheckResourceAttrSet(testCloneResName, "ipv6"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInstanceClone_target(t *testing.T) {
+	t.Parallel()
+
+	label := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Target(t, label, testRegion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						testCloneResName, "id", "linode_instance.target", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						testCloneResName, "ip_address", "linode_instance.target", "ip_address",
+					),
+					resource.TestCheckResourceAttr(testCloneResName, "label", label+"-target"),
+					resource.TestCheckResourceAttr(testCloneResName, "region", testRegion),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,28 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+)
+
+type TemplateData struct {
+	Label  string
+	Region string
+}
+
+func Basic(t *testing.T, label, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_clone_basic", TemplateData{
+			Label:  label,
+			Region: region,
+		})
+}
+
+func Target(t *testing.T, label, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_clone_target", TemplateData{
+			Label:  label,
+			Region: region,
+		})
+}
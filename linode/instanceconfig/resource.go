@@ -137,6 +137,12 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 
 	linodeID := d.Get("linode_id").(int)
 
+	unlock, err := meta.(*helper.ProviderMeta).LockLinode(ctx, linodeID)
+	if err != nil {
+		return diag.Errorf("failed to lock linode %d: %s", linodeID, err)
+	}
+	defer unlock()
+
 	createOpts := linodego.InstanceConfigCreateOptions{
 		Label:       d.Get("label").(string),
 		Comments:    d.Get("comments").(string),
@@ -199,6 +205,12 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 
 	linodeID := d.Get("linode_id").(int)
 
+	unlock, err := meta.(*helper.ProviderMeta).LockLinode(ctx, linodeID)
+	if err != nil {
+		return diag.Errorf("failed to lock linode %d: %s", linodeID, err)
+	}
+	defer unlock()
+
 	ctx = helper.SetLogFieldBulk(ctx, map[string]any{
 		"id":        id,
 		"linode_id": linodeID,
@@ -294,7 +306,8 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	if shouldUpdate {
 		if powerOffRequired {
 			if err := instancehelpers.ShutdownInstanceForVPCInterfaceUpdate(
-				ctx, &client, meta.(*helper.ProviderMeta).Config.SkipImplicitReboots, linodeID, helper.GetDeadlineSeconds(ctx, d),
+				ctx, &client, meta.(*helper.ProviderMeta).EventWatcher,
+				meta.(*helper.ProviderMeta).Config.SkipImplicitReboots, linodeID, helper.GetDeadlineSeconds(ctx, d),
 			); err != nil {
 				return diag.Errorf("failed to shutdown linode instance for VPC interface update: %s", err)
 			}
@@ -340,6 +353,12 @@ func deleteResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 
 	linodeID := d.Get("linode_id").(int)
 
+	unlock, err := meta.(*helper.ProviderMeta).LockLinode(ctx, linodeID)
+	if err != nil {
+		return diag.Errorf("failed to lock linode %d: %s", linodeID, err)
+	}
+	defer unlock()
+
 	inst, err := client.GetInstance(ctx, linodeID)
 	if err != nil {
 		return diag.Errorf("Error finding the specified Linode Instance: %s", err)
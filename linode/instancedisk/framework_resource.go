@@ -103,6 +103,13 @@ func (r *Resource) Create(
 		createOpts.RootPass = plan.RootPass.ValueString()
 	}
 
+	unlock, err := r.Meta.LockLinode(ctx, linodeID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to Lock Linode %d", linodeID), err.Error())
+		return
+	}
+	defer unlock()
+
 	p, err := client.NewEventPoller(ctx, linodeID, linodego.EntityLinode, linodego.ActionDiskCreate)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to Poll for Events", err.Error())
@@ -112,9 +119,10 @@ func (r *Resource) Create(
 	tflog.Debug(ctx, "client.CreateInstanceDisk(...)")
 	disk, err := client.CreateInstanceDisk(ctx, linodeID, createOpts)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helper.FrameworkAddAPIError(
+			ctx, &resp.Diagnostics, req.Plan,
 			fmt.Sprintf("Failed to Create Disk on Linode Instance %d", linodeID),
-			err.Error(),
+			err,
 		)
 		return
 	}
@@ -244,6 +252,13 @@ func (r *Resource) Update(
 		return
 	}
 
+	unlock, err := r.Meta.LockLinode(ctx, linodeID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to Lock Linode %d", linodeID), err.Error())
+		return
+	}
+	defer unlock()
+
 	if !state.Size.Equal(plan.Size) {
 		if err := handleDiskResize(
 			ctx, client, linodeID, id, size, timeoutSeconds,
@@ -268,8 +283,10 @@ func (r *Resource) Update(
 			"options": updateOpts,
 		})
 		if _, err := client.UpdateInstanceDisk(ctx, linodeID, id, updateOpts); err != nil {
-			resp.Diagnostics.AddError(
-				fmt.Sprintf("Failed to Update Disk %d", id), err.Error(),
+			helper.FrameworkAddAPIError(
+				ctx, &resp.Diagnostics, req.Plan,
+				fmt.Sprintf("Failed to Update Disk %d", id),
+				err,
 			)
 			return
 		}
@@ -336,6 +353,13 @@ func (r *Resource) Delete(
 	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
 	defer cancel()
 
+	unlock, err := r.Meta.LockLinode(ctx, linodeID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to Lock Linode %d", linodeID), err.Error())
+		return
+	}
+	defer unlock()
+
 	configID, err := helper.GetCurrentBootedConfig(ctx, client, linodeID)
 	if err != nil {
 		resp.Diagnostics.AddWarning(
@@ -373,7 +397,7 @@ func (r *Resource) Delete(
 			return
 		}
 		if err := instance.SafeShutdownInstance(
-			ctx, client, linodeID, timeoutSeconds,
+			ctx, client, r.Meta.EventWatcher, linodeID, timeoutSeconds,
 		); err != nil {
 			resp.Diagnostics.AddError(
 				fmt.Sprintf("Failed to Shutdown Linode Instance %d", linodeID),
@@ -413,7 +437,7 @@ func (r *Resource) Delete(
 	// Reboot the instance if necessary
 	if shouldShutdown && !diskInConfig {
 		if err := instance.BootInstanceSync(
-			ctx, client, linodeID, configID, timeoutSeconds,
+			ctx, client, r.Meta.EventWatcher, linodeID, configID, timeoutSeconds,
 		); err != nil {
 			resp.Diagnostics.AddError(
 				fmt.Sprintf("Failed to Boot Instance %d", linodeID), err.Error(),
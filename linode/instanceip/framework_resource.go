@@ -51,6 +51,13 @@ func (r *Resource) Create(
 		return
 	}
 
+	unlock, err := r.Meta.LockLinode(ctx, linodeID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to Lock Linode %d", linodeID), err.Error())
+		return
+	}
+	defer unlock()
+
 	isPublic := plan.Public.ValueBool()
 
 	client := r.Meta.Client
@@ -75,12 +82,13 @@ func (r *Resource) Create(
 		})
 
 		if _, err := client.UpdateIPAddress(ctx, ip.Address, options); err != nil {
-			resp.Diagnostics.AddError(
+			helper.FrameworkAddAPIError(
+				ctx, &resp.Diagnostics, req.Plan,
 				fmt.Sprintf(
 					"failed to set RDNS for instance (%d) ip (%s)",
 					linodeID, ip.Address,
 				),
-				err.Error(),
+				err,
 			)
 			return
 		}
@@ -275,6 +283,13 @@ func (r *Resource) Delete(
 		return
 	}
 
+	unlock, err := r.Meta.LockLinode(ctx, linodeID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to Lock Linode %d", linodeID), err.Error())
+		return
+	}
+	defer unlock()
+
 	tflog.Debug(ctx, "client.DeleteInstanceIPAddress(...)")
 	if err := client.DeleteInstanceIPAddress(ctx, linodeID, address); err != nil {
 		if lErr, ok := err.(*linodego.Error); (ok && lErr.Code != 404) || !ok {
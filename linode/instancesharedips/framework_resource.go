@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
@@ -31,7 +32,11 @@ type Resource struct {
 }
 
 func CreateOrUpdateSharedIPs(
-	ctx context.Context, client *linodego.Client, plan *ResourceModel, diags *diag.Diagnostics,
+	ctx context.Context,
+	client *linodego.Client,
+	tfPlan tfsdk.Plan,
+	plan *ResourceModel,
+	diags *diag.Diagnostics,
 ) {
 	linodeID := helper.FrameworkSafeInt64ToInt(plan.LinodeID.ValueInt64(), diags)
 	if diags.HasError() {
@@ -53,9 +58,10 @@ func CreateOrUpdateSharedIPs(
 
 	err := client.ShareIPAddresses(ctx, createOpts)
 	if err != nil {
-		diags.AddError(
+		helper.FrameworkAddAPIError(
+			ctx, diags, tfPlan,
 			fmt.Sprintf("failed to update ips for linode %d", linodeID),
-			err.Error(),
+			err,
 		)
 		return
 	}
@@ -84,7 +90,19 @@ func (r *Resource) Create(
 
 	ctx = populateLogAttributes(ctx, plan)
 
-	CreateOrUpdateSharedIPs(ctx, client, &plan, &resp.Diagnostics)
+	linodeID := helper.FrameworkSafeInt64ToInt(plan.LinodeID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := r.Meta.LockLinode(ctx, linodeID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to Lock Linode %d", linodeID), err.Error())
+		return
+	}
+	defer unlock()
+
+	CreateOrUpdateSharedIPs(ctx, client, req.Plan, &plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -151,7 +169,19 @@ func (r *Resource) Update(
 	client := r.Meta.Client
 
 	if !plan.Addresses.Equal(state.Addresses) {
-		CreateOrUpdateSharedIPs(ctx, client, &plan, &resp.Diagnostics)
+		linodeID := helper.FrameworkSafeInt64ToInt(plan.LinodeID.ValueInt64(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		unlock, err := r.Meta.LockLinode(ctx, linodeID)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to Lock Linode %d", linodeID), err.Error())
+			return
+		}
+		defer unlock()
+
+		CreateOrUpdateSharedIPs(ctx, client, req.Plan, &plan, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -191,6 +221,13 @@ func (r *Resource) Delete(
 
 	client := r.Meta.Client
 
+	unlock, err := r.Meta.LockLinode(ctx, linodeID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to Lock Linode %d", linodeID), err.Error())
+		return
+	}
+	defer unlock()
+
 	options := linodego.IPAddressesShareOptions{
 		LinodeID: linodeID,
 		IPs:      []string{},
@@ -200,7 +237,7 @@ func (r *Resource) Delete(
 		"options": options,
 	})
 
-	err := client.ShareIPAddresses(ctx, options)
+	err = client.ShareIPAddresses(ctx, options)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed to update shared ips for linode %d", linodeID),
@@ -0,0 +1,85 @@
+package instancesnapshot
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+type ResourceModel struct {
+	ID        types.String      `tfsdk:"id"`
+	LinodeID  types.Int64       `tfsdk:"linode_id"`
+	Label     types.String      `tfsdk:"label"`
+	Status    types.String      `tfsdk:"status"`
+	Type      types.String      `tfsdk:"type"`
+	Available types.Bool        `tfsdk:"available"`
+	Configs   types.List        `tfsdk:"configs"`
+	Disks     types.List        `tfsdk:"disks"`
+	Created   timetypes.RFC3339 `tfsdk:"created"`
+	Updated   timetypes.RFC3339 `tfsdk:"updated"`
+	Finished  timetypes.RFC3339 `tfsdk:"finished"`
+	Timeouts  timeouts.Value    `tfsdk:"timeouts"`
+}
+
+func (data *ResourceModel) FlattenSnapshot(
+	ctx context.Context,
+	snapshot *linodego.InstanceSnapshot,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) {
+	data.ID = helper.KeepOrUpdateString(data.ID, strconv.Itoa(snapshot.ID), preserveKnown)
+	data.Label = helper.KeepOrUpdateString(data.Label, snapshot.Label, preserveKnown)
+	data.Status = helper.KeepOrUpdateString(data.Status, string(snapshot.Status), preserveKnown)
+	data.Type = helper.KeepOrUpdateString(data.Type, snapshot.Type, preserveKnown)
+	data.Available = helper.KeepOrUpdateBool(data.Available, snapshot.Available, preserveKnown)
+
+	configs, d := types.ListValueFrom(ctx, types.StringType, snapshot.Configs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+	data.Configs = helper.KeepOrUpdateValue(data.Configs, configs, preserveKnown)
+
+	disks, d := flattenSnapshotDisks(snapshot.Disks)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+	data.Disks = helper.KeepOrUpdateValue(data.Disks, disks, preserveKnown)
+
+	data.Created = helper.KeepOrUpdateValue(
+		data.Created, timetypes.NewRFC3339TimePointerValue(snapshot.Created), preserveKnown,
+	)
+	data.Updated = helper.KeepOrUpdateValue(
+		data.Updated, timetypes.NewRFC3339TimePointerValue(snapshot.Updated), preserveKnown,
+	)
+	data.Finished = helper.KeepOrUpdateValue(
+		data.Finished, timetypes.NewRFC3339TimePointerValue(snapshot.Finished), preserveKnown,
+	)
+}
+
+func flattenSnapshotDisks(disks []*linodego.InstanceSnapshotDisk) (types.List, diag.Diagnostics) {
+	result := make([]attr.Value, len(disks))
+
+	for i, disk := range disks {
+		obj, d := types.ObjectValue(diskObjectType.AttrTypes, map[string]attr.Value{
+			"label":      types.StringValue(disk.Label),
+			"size":       types.Int64Value(int64(disk.Size)),
+			"filesystem": types.StringValue(disk.Filesystem),
+		})
+		if d.HasError() {
+			return types.ListNull(diskObjectType), d
+		}
+
+		result[i] = obj
+	}
+
+	return types.ListValue(diskObjectType, result)
+}
@@ -0,0 +1,81 @@
+//go:build unit
+
+package instancesnapshot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenSnapshot(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	finished := created.Add(5 * time.Minute)
+
+	snapshot := &linodego.InstanceSnapshot{
+		ID:        456,
+		Label:     "pre-upgrade",
+		Status:    linodego.SnapshotSuccessful,
+		Type:      "snapshot",
+		Created:   &created,
+		Updated:   &finished,
+		Finished:  &finished,
+		Configs:   []string{"My Debian 12 Profile"},
+		Available: true,
+		Disks: []*linodego.InstanceSnapshotDisk{
+			{
+				Label:      "Debian 12 Disk",
+				Size:       25088,
+				Filesystem: "ext4",
+			},
+		},
+	}
+
+	var diags diag.Diagnostics
+
+	data := &ResourceModel{}
+	data.FlattenSnapshot(context.Background(), snapshot, false, &diags)
+
+	assert.False(t, diags.HasError(), "Expected no error")
+
+	assert.Equal(t, types.StringValue("456"), data.ID)
+	assert.Equal(t, types.StringValue("pre-upgrade"), data.Label)
+	assert.Equal(t, types.StringValue("successful"), data.Status)
+	assert.Equal(t, types.StringValue("snapshot"), data.Type)
+	assert.Equal(t, types.BoolValue(true), data.Available)
+	assert.Contains(t, data.Configs.String(), "My Debian 12 Profile")
+	assert.Len(t, data.Disks.Elements(), 1)
+	assert.Contains(t, data.Disks.String(), "Debian 12 Disk")
+	assert.Contains(t, data.Disks.String(), "25088")
+	assert.Contains(t, data.Created.String(), "2024-01-02T03:04:05Z")
+	assert.Contains(t, data.Finished.String(), "2024-01-02T03:09:05Z")
+}
+
+func TestFlattenSnapshot_preserveKnown(t *testing.T) {
+	snapshot := &linodego.InstanceSnapshot{
+		ID:     456,
+		Label:  "pre-upgrade",
+		Status: linodego.SnapshotPending,
+		Type:   "snapshot",
+	}
+
+	var diags diag.Diagnostics
+
+	data := &ResourceModel{
+		ID:     types.StringUnknown(),
+		Label:  types.StringValue("pre-upgrade"),
+		Status: types.StringUnknown(),
+	}
+	data.FlattenSnapshot(context.Background(), snapshot, true, &diags)
+
+	assert.False(t, diags.HasError(), "Expected no error")
+
+	assert.Equal(t, types.StringValue("456"), data.ID)
+	assert.Equal(t, types.StringValue("pre-upgrade"), data.Label)
+	assert.Equal(t, types.StringValue("pending"), data.Status)
+}
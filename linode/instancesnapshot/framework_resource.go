@@ -0,0 +1,260 @@
+package instancesnapshot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+const (
+	DefaultSnapshotCreateTimeout = 30 * time.Minute
+	DefaultSnapshotUpdateTimeout = 10 * time.Minute
+	DefaultSnapshotDeleteTimeout = 10 * time.Minute
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_instance_snapshot",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+					Update: true,
+					Delete: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, plan)
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, DefaultSnapshotCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	client := r.Meta.Client
+
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(createTimeout.Seconds(), &resp.Diagnostics)
+	linodeID := helper.FrameworkSafeInt64ToInt(plan.LinodeID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := r.Meta.LockLinode(ctx, linodeID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to Lock Linode %d", linodeID), err.Error())
+		return
+	}
+	defer unlock()
+
+	p, err := r.Meta.NewEventWaiter(ctx, helper.EventFilter{
+		EntityType: linodego.EntityLinode,
+		EntityID:   linodeID,
+		Action:     linodego.ActionLinodeSnapshot,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Initialize Event Poller", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "client.CreateInstanceSnapshot(...)", map[string]any{
+		"label": plan.Label.ValueString(),
+	})
+
+	snapshot, err := client.CreateInstanceSnapshot(ctx, linodeID, plan.Label.ValueString())
+	if err != nil {
+		helper.FrameworkAddAPIError(
+			ctx, &resp.Diagnostics, req.Plan,
+			fmt.Sprintf("Failed to Create Snapshot of Linode Instance %d", linodeID),
+			err,
+		)
+		return
+	}
+
+	// Add resource to TF states earlier to prevent
+	// dangling resources (resources created but not managed by TF)
+	resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(strconv.Itoa(snapshot.ID)))
+	resp.State.SetAttribute(ctx, path.Root("linode_id"), plan.LinodeID)
+
+	ctx = tflog.SetField(ctx, "snapshot_id", snapshot.ID)
+
+	if _, err := p.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Wait for Snapshot of Linode Instance %d to Finish", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Snapshot event finished")
+
+	tflog.Trace(ctx, "client.GetInstanceSnapshot(...)")
+
+	snapshot, err = client.GetInstanceSnapshot(ctx, linodeID, snapshot.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Snapshot of Linode Instance %d", linodeID), err.Error(),
+		)
+		return
+	}
+
+	plan.FlattenSnapshot(ctx, snapshot, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	id := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+	linodeID := helper.FrameworkSafeInt64ToInt(state.LinodeID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshot, err := r.Meta.Client.GetInstanceSnapshot(ctx, linodeID, id)
+	if err != nil {
+		if linodego.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Snapshot Not Found",
+				fmt.Sprintf(
+					"Removing snapshot %d of Linode Instance %d from state because it no longer exists",
+					id, linodeID,
+				),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Snapshot %d of Linode Instance %d", id, linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	state.FlattenSnapshot(ctx, snapshot, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only applies the timeouts, all other attributes require replacement.
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the snapshot from the state. The API does not support
+// deleting snapshots, they are replaced by the next snapshot of the Linode.
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	tflog.Info(ctx, "Leaving the snapshot in place, snapshots can not be deleted")
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	tflog.Debug(ctx, "Import "+r.Config.Name)
+	helper.ImportStateWithMultipleIDs(
+		ctx, req, resp,
+		[]helper.ImportableID{
+			{
+				Name:          "linode_id",
+				TypeConverter: helper.IDTypeConverterInt64,
+			},
+			{
+				Name:          "id",
+				TypeConverter: helper.IDTypeConverterString,
+			},
+		},
+	)
+}
+
+func populateLogAttributes(ctx context.Context, model ResourceModel) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"linode_id":   model.LinodeID.ValueInt64(),
+		"snapshot_id": model.ID.ValueString(),
+	})
+}
@@ -0,0 +1,77 @@
+//go:build integration || instancesnapshot
+
+package instancesnapshot_test
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/instancesnapshot/tmpl"
+)
+
+const testSnapshotResName = "linode_instance_snapshot.foobar"
+
+var testRegion string
+
+func init() {
+	region, err := acceptance.GetRandomRegionWithCaps(nil, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccResourceInstanceSnapshot_basic(t *testing.T) {
+	t.Parallel()
+
+	label := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, label, testRegion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testSnapshotResName, "id"),
+					resource.TestCheckResourceAttrPair(
+						testSnapshotResName, "linode_id", "linode_instance.foobar", "id",
+					),
+					resource.TestCheckResourceAttr(testSnapshotResName, "label", label+"-snapshot"),
+					resource.TestCheckResourceAttr(testSnapshotResName, "type", "snapshot"),
+					resource.TestCheckResourceAttr(testSnapshotResName, "status", "successful"),
+					resource.TestCheckResourceAttr(testSnapshotResName, "available", "true"),
+					resource.TestCheckResourceAttrSet(testSnapshotResName, "disks.#"),
+					resource.TestCheckResourceAttrSet(testSnapshotResName, "created"),
+					resource.TestCheckResourceAttrSet(testSnapshotResName, "finished"),
+				),
+			},
+			{
+				ResourceName:            testSnapshotResName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       resourceImportStateID,
+				ImportStateVerifyIgnore: []string{"updated"},
+			},
+		},
+	})
+}
+
+func resourceImportStateID(s *terraform.State) (string, error) {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_instance_snapshot" {
+			continue
+		}
+
+		return fmt.Sprintf("%s,%s", rs.Primary.Attributes["linode_id"], rs.Primary.ID), nil
+	}
+
+	return "", fmt.Errorf("Error finding linode_instance_snapshot")
+}
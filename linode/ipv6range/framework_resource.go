@@ -84,14 +84,16 @@ func (r *Resource) Create(
 	ipv6range, err := client.CreateIPv6Range(ctx, createOpts)
 	if err != nil {
 		if linodeIdConfigured {
-			resp.Diagnostics.AddError(
+			helper.FrameworkAddAPIError(
+				ctx, &resp.Diagnostics, req.Plan,
 				fmt.Sprintf("Failed to create ipv6 range for linode_id: %v", createOpts.LinodeID),
-				err.Error(),
+				err,
 			)
 		} else {
-			resp.Diagnostics.AddError(
+			helper.FrameworkAddAPIError(
+				ctx, &resp.Diagnostics, req.Plan,
 				fmt.Sprintf("Failed to create ipv6 range for route_target: %v", createOpts.RouteTarget),
-				err.Error(),
+				err,
 			)
 		}
 		return
@@ -228,9 +230,10 @@ func (r *Resource) Update(
 
 		err := client.InstancesAssignIPs(ctx, updateOpts)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			helper.FrameworkAddAPIError(
+				ctx, &resp.Diagnostics, req.Plan,
 				"Failed to assign ipv6 address to instance.",
-				err.Error(),
+				err,
 			)
 			return
 		}
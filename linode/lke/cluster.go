@@ -549,3 +549,46 @@ func poolHasAnyOfTags(pool linodego.LKENodePool, tagSet map[string]bool) *string
 	}
 	return nil
 }
+
+// nodeCountsByType returns the total number of nodes of each type in the given pools.
+func nodeCountsByType(pools []linodego.LKENodePool) map[string]int {
+	result := make(map[string]int)
+	for _, pool := range pools {
+		result[pool.Type] += pool.Count
+	}
+
+	return result
+}
+
+// getClusterPrice returns the total price of the given number of nodes
+// by type and, if enabled, the high availability control plane of a
+// cluster in the given region.
+func getClusterPrice(
+	ctx context.Context,
+	client *linodego.Client,
+	region string,
+	nodeCounts map[string]int,
+	highAvailability bool,
+) (helper.Price, error) {
+	var result helper.Price
+
+	for typeID, count := range nodeCounts {
+		price, err := helper.GetLinodeTypePrice(ctx, client, typeID, region, false)
+		if err != nil {
+			return helper.Price{}, err
+		}
+
+		result = result.Add(price.Multiply(count))
+	}
+
+	if highAvailability {
+		price, err := helper.GetLKEHAControlPlanePrice(ctx, client, region)
+		if err != nil {
+			return helper.Price{}, err
+		}
+
+		result = result.Add(price)
+	}
+
+	return result, nil
+}
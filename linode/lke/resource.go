@@ -40,6 +40,9 @@ func Resource() *schema.Resource {
 			customDiffValidateOptionalCount,
 			linodediffs.ComputedWithDefault("tags", []string{}),
 			linodediffs.CaseInsensitiveSet("tags"),
+			linodediffs.TagsAll(),
+			linodediffs.RegionCapabilities(requiredRegionCapabilities, "control_plane"),
+			linodediffs.ComputedPrice(plannedPrice, "region", "pool", "control_plane"),
 		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(createLKETimeout),
@@ -117,7 +120,7 @@ func readResource(ctx context.Context, d *schema.ResourceData, meta interface{})
 	d.Set("label", cluster.Label)
 	d.Set("k8s_version", cluster.K8sVersion)
 	d.Set("region", cluster.Region)
-	d.Set("tags", cluster.Tags)
+	meta.(*helper.ProviderMeta).Config.GetProviderTags().SDKv2SetTags(d, cluster.Tags)
 	d.Set("status", cluster.Status)
 	d.Set("kubeconfig", kubeconfig.KubeConfig)
 	d.Set("dashboard_url", dashboard.URL)
@@ -133,6 +136,21 @@ func readResource(ctx context.Context, d *schema.ResourceData, meta interface{})
 	d.Set("pool", p)
 	d.Set("control_plane", []map[string]interface{}{flattenedControlPlane})
 
+	// Types are memoized per provider instance, so the client held by
+	// the provider meta is used rather than the local copy.
+	price, err := getClusterPrice(
+		ctx, &meta.(*helper.ProviderMeta).Client, cluster.Region,
+		nodeCountsByType(pools), cluster.ControlPlane.HighAvailability,
+	)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to get LKE cluster price", map[string]any{
+			"error": err.Error(),
+		})
+	} else {
+		d.Set("monthly_price", price.Monthly)
+		d.Set("hourly_price", price.Hourly)
+	}
+
 	return nil
 }
 
@@ -183,10 +201,9 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		})
 	}
 
-	if tagsRaw, tagsOk := d.GetOk("tags"); tagsOk {
-		tags := helper.ExpandStringSet(tagsRaw.(*schema.Set))
-		createOpts.Tags = tags
-	}
+	createOpts.Tags = meta.(*helper.ProviderMeta).Config.GetProviderTags().MergeTags(
+		helper.ExpandStringSet(d.Get("tags").(*schema.Set)),
+	)
 
 	tflog.Debug(ctx, "client.CreateLKECluster(...)", map[string]any{
 		"options": createOpts,
@@ -249,11 +266,23 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		updateOpts.ControlPlane = &expandedControlPlane
 	}
 
-	if d.HasChange("tags") {
-		tags := helper.ExpandStringSet(d.Get("tags").(*schema.Set))
+	if d.HasChanges("tags", "tags_all") {
+		providerTags := providerMeta.Config.GetProviderTags()
+
+		// Ignored tags must be preserved when updating the tags
+		var remoteTags []string
+		if providerTags.HasIgnoreTags() {
+			cluster, err := client.GetLKECluster(ctx, id)
+			if err != nil {
+				return diag.Errorf("failed to get LKE Cluster %d: %s", id, err)
+			}
+			remoteTags = cluster.Tags
+		}
+
+		tags := providerTags.UpdateTags(helper.ExpandStringSet(d.Get("tags").(*schema.Set)), remoteTags)
 		updateOpts.Tags = &tags
 	}
-	if d.HasChanges("label", "tags", "k8s_version", "control_plane") {
+	if d.HasChanges("label", "tags", "tags_all", "k8s_version", "control_plane") {
 		tflog.Debug(ctx, "client.UpdateLKECluster(...)", map[string]any{
 			"options": updateOpts,
 		})
@@ -406,6 +435,53 @@ func populateLogAttributes(ctx context.Context, d *schema.ResourceData) context.
 	})
 }
 
+// requiredRegionCapabilities returns the region capabilities
+// required by the planned configuration of a cluster.
+func requiredRegionCapabilities(diff *schema.ResourceDiff) []string {
+	if diff.Get("control_plane.0.high_availability").(bool) {
+		return []string{linodego.CapabilityLkeHaControlPlanes}
+	}
+
+	return nil
+}
+
+// plannedPrice returns the price of the planned node pools and control plane
+// of a cluster, or nil if the region or a node pool is not yet known.
+func plannedPrice(
+	ctx context.Context,
+	diff *schema.ResourceDiff,
+	client *linodego.Client,
+) (*helper.Price, error) {
+	if !diff.NewValueKnown("region") || !diff.NewValueKnown("pool") {
+		return nil, nil
+	}
+
+	nodeCounts := make(map[string]int)
+
+	for _, pool := range expandLinodeLKENodePoolSpecs(diff.Get("pool").([]any), true) {
+		count := pool.Count
+		if count == 0 {
+			count = pool.AutoScalerMin
+		}
+
+		if pool.Type == "" || count == 0 {
+			return nil, nil
+		}
+
+		nodeCounts[pool.Type] += count
+	}
+
+	price, err := getClusterPrice(
+		ctx, client, diff.Get("region").(string), nodeCounts,
+		diff.Get("control_plane.0.high_availability").(bool),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &price, nil
+}
+
 // customDiffValidateOptionalCount ensures an autoscaler must be
 // defined is count is undefined.
 //
@@ -28,6 +28,12 @@ var resourceSchema = map[string]*schema.Schema{
 		Computed:    true,
 		Description: "An array of tags applied to this object. Tags are for organizational purposes only.",
 	},
+	"tags_all": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+		Description: "All tags applied to this object, including those inherited from the provider's default_tags.",
+	},
 	"external_pool_tags": {
 		Type:        schema.TypeSet,
 		Elem:        &schema.Schema{Type: schema.TypeString},
@@ -57,6 +63,18 @@ var resourceSchema = map[string]*schema.Schema{
 		Computed:    true,
 		Description: "The dashboard URL of the cluster.",
 	},
+	"monthly_price": {
+		Type:     schema.TypeFloat,
+		Computed: true,
+		Description: "The monthly price of the cluster's node pools and high availability control plane " +
+			"in US dollars, taking region-specific pricing into account.",
+	},
+	"hourly_price": {
+		Type:     schema.TypeFloat,
+		Computed: true,
+		Description: "The hourly price of the cluster's node pools and high availability control plane " +
+			"in US dollars, taking region-specific pricing into account.",
+	},
 	"status": {
 		Type:        schema.TypeString,
 		Computed:    true,
@@ -0,0 +1,46 @@
+package lkekubeconfig
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+)
+
+var frameworkEphemeralResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"cluster_id": schema.Int64Attribute{
+			Description: "The ID of the LKE cluster to get the kubeconfig of.",
+			Required:    true,
+		},
+		"decode": schema.BoolAttribute{
+			Description: "If true, the connection details of the kubeconfig will be decoded " +
+				"into the host, cluster_ca_certificate, token, client_certificate, and client_key attributes.",
+			Optional: true,
+		},
+		"kubeconfig": schema.StringAttribute{
+			Description: "The base64-encoded kubeconfig of the LKE cluster.",
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"host": schema.StringAttribute{
+			Description: "The URL of the cluster's Kubernetes API server.",
+			Computed:    true,
+		},
+		"cluster_ca_certificate": schema.StringAttribute{
+			Description: "The PEM-encoded CA certificate of the cluster.",
+			Computed:    true,
+		},
+		"token": schema.StringAttribute{
+			Description: "The token used to authenticate with the cluster.",
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"client_certificate": schema.StringAttribute{
+			Description: "The PEM-encoded client certificate used to authenticate with the cluster.",
+			Computed:    true,
+		},
+		"client_key": schema.StringAttribute{
+			Description: "The PEM-encoded client key used to authenticate with the cluster.",
+			Computed:    true,
+			Sensitive:   true,
+		},
+	},
+}
@@ -0,0 +1,47 @@
+package lkekubeconfig
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/parsekubeconfig"
+)
+
+// EphemeralResourceModel describes the Terraform ephemeral resource data model
+// to match the ephemeral resource schema.
+type EphemeralResourceModel struct {
+	ClusterID            types.Int64  `tfsdk:"cluster_id"`
+	Decode               types.Bool   `tfsdk:"decode"`
+	Kubeconfig           types.String `tfsdk:"kubeconfig"`
+	Host                 types.String `tfsdk:"host"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	Token                types.String `tfsdk:"token"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+}
+
+func (data *EphemeralResourceModel) FlattenKubeconfig(kubeconfig *linodego.LKEClusterKubeconfig) error {
+	data.Kubeconfig = types.StringValue(kubeconfig.KubeConfig)
+
+	data.Host = types.StringNull()
+	data.ClusterCACertificate = types.StringNull()
+	data.Token = types.StringNull()
+	data.ClientCertificate = types.StringNull()
+	data.ClientKey = types.StringNull()
+
+	if !data.Decode.ValueBool() {
+		return nil
+	}
+
+	var parsed parsekubeconfig.KubeconfigModel
+	if err := parsed.ParseKubeconfig(kubeconfig.KubeConfig); err != nil {
+		return err
+	}
+
+	data.Host = parsed.Host
+	data.ClusterCACertificate = parsed.ClusterCACertificate
+	data.Token = parsed.Token
+	data.ClientCertificate = parsed.ClientCertificate
+	data.ClientKey = parsed.ClientKey
+
+	return nil
+}
@@ -0,0 +1,53 @@
+//go:build unit
+
+package lkekubeconfig
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testKubeconfig = base64.StdEncoding.EncodeToString([]byte(`
+apiVersion: v1
+kind: Config
+current-context: lke12345-ctx
+clusters:
+- name: lke12345
+  cluster:
+    server: https://12345.us-east-1.linodelke.net:443
+    certificate-authority-data: ` + base64.StdEncoding.EncodeToString([]byte("ca-certificate")) + `
+users:
+- name: lke12345-admin
+  user:
+    token: secret-token
+contexts:
+- name: lke12345-ctx
+  context:
+    cluster: lke12345
+    user: lke12345-admin
+`))
+
+func TestFlattenKubeconfig(t *testing.T) {
+	kubeconfig := &linodego.LKEClusterKubeconfig{KubeConfig: testKubeconfig}
+
+	data := EphemeralResourceModel{Decode: types.BoolNull()}
+	require.NoError(t, data.FlattenKubeconfig(kubeconfig))
+
+	assert.Equal(t, testKubeconfig, data.Kubeconfig.ValueString())
+	assert.True(t, data.Host.IsNull())
+	assert.True(t, data.Token.IsNull())
+
+	data = EphemeralResourceModel{Decode: types.BoolValue(true)}
+	require.NoError(t, data.FlattenKubeconfig(kubeconfig))
+
+	assert.Equal(t, "https://12345.us-east-1.linodelke.net:443", data.Host.ValueString())
+	assert.Equal(t, "ca-certificate", data.ClusterCACertificate.ValueString())
+	assert.Equal(t, "secret-token", data.Token.ValueString())
+	assert.True(t, data.ClientCertificate.IsNull())
+	assert.True(t, data.ClientKey.IsNull())
+}
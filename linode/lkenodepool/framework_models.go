@@ -24,6 +24,8 @@ type NodePoolModel struct {
 	Autoscaler     []NodePoolAutoscalerModel `tfsdk:"autoscaler"`
 	Taints         []NodePoolTaintModel      `tfsdk:"taint"`
 	Labels         types.Map                 `tfsdk:"labels"`
+	MonthlyPrice   types.Float64             `tfsdk:"monthly_price"`
+	HourlyPrice    types.Float64             `tfsdk:"hourly_price"`
 }
 
 type NodePoolAutoscalerModel struct {
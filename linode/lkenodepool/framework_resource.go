@@ -80,6 +80,8 @@ func (r *Resource) Read(
 		return
 	}
 
+	r.setPrice(ctx, &data, clusterID, nodePool)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	tflog.Trace(ctx, "Read linode_lke_node_pool done")
 }
@@ -120,7 +122,11 @@ func (r *Resource) Create(
 	})
 	pool, err := client.CreateLKENodePool(ctx, clusterID, createOpts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Linode Node Pool", err.Error())
+		helper.FrameworkAddAPIError(
+			ctx, &resp.Diagnostics, req.Plan,
+			"Error creating Linode Node Pool",
+			err,
+		)
 		return
 	}
 
@@ -149,6 +155,8 @@ func (r *Resource) Create(
 	// TODO: Remove when Crossplane empty string ID issue is resolved
 	plan.ID = types.StringValue(strconv.Itoa(readyPool.ID))
 
+	r.setPrice(ctx, &plan, clusterID, readyPool)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	tflog.Trace(ctx, "Create linode_lke_node_pool done")
 }
@@ -190,7 +198,11 @@ func (r *Resource) Update(
 	})
 	pool, err := client.UpdateLKENodePool(ctx, clusterID, poolID, updateOpts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating a Linode Node Pool", err.Error())
+		helper.FrameworkAddAPIError(
+			ctx, &resp.Diagnostics, req.Plan,
+			"Error updating a Linode Node Pool",
+			err,
+		)
 		return
 	}
 
@@ -208,6 +220,8 @@ func (r *Resource) Update(
 
 	plan.FlattenLKENodePool(ctx, readyPool, true, &resp.Diagnostics)
 
+	r.setPrice(ctx, &plan, clusterID, readyPool)
+
 	// Workaround for Crossplane issue where ID is not
 	// properly populated in plan
 	// See TPT-2865 for more details
@@ -261,6 +275,35 @@ func (r *Resource) Delete(
 	tflog.Trace(ctx, "Delete linode_lke_node_pool done")
 }
 
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	helper.FrameworkModifyPlanPrice(ctx, req, resp, func() (*helper.Price, error) {
+		var plan NodePoolModel
+
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		if resp.Diagnostics.HasError() || r.Meta == nil ||
+			!helper.FrameworkValuesKnown(plan.ClusterID, plan.Type, plan.Count) {
+			return nil, nil
+		}
+
+		price, err := getPrice(
+			ctx,
+			r.Meta.Client,
+			int(plan.ClusterID.ValueInt64()),
+			plan.Type.ValueString(),
+			int(plan.Count.ValueInt64()),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return &price, nil
+	}, "cluster_id", "type", "node_count")
+}
+
 func (r *Resource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
@@ -290,3 +333,34 @@ func AddPoolResource(
 	resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(strconv.Itoa(p.ID)))
 	resp.State.SetAttribute(ctx, path.Root("cluster_id"), plan.ClusterID)
 }
+
+// setPrice sets the computed price attributes of the given node pool.
+func (r *Resource) setPrice(ctx context.Context, data *NodePoolModel, clusterID int, pool *linodego.LKENodePool) {
+	helper.FrameworkSetPrice(ctx, &data.MonthlyPrice, &data.HourlyPrice, func() (helper.Price, error) {
+		return getPrice(ctx, r.Meta.Client, clusterID, pool.Type, pool.Count)
+	})
+}
+
+// getPrice returns the price of the given number of nodes
+// of the given type in the region of the given cluster.
+func getPrice(
+	ctx context.Context,
+	client *linodego.Client,
+	clusterID int,
+	typeID string,
+	count int,
+) (helper.Price, error) {
+	tflog.Trace(ctx, "client.GetLKECluster(...)")
+
+	cluster, err := client.GetLKECluster(ctx, clusterID)
+	if err != nil {
+		return helper.Price{}, fmt.Errorf("failed to get LKE cluster %d: %w", clusterID, err)
+	}
+
+	price, err := helper.GetLinodeTypePrice(ctx, client, typeID, cluster.Region, false)
+	if err != nil {
+		return helper.Price{}, err
+	}
+
+	return price.Multiply(count), nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -81,6 +82,22 @@ var resourceSchema = schema.Schema{
 			Computed:    true,
 			Default:     helper.EmptyMapDefault(types.StringType),
 		},
+		"monthly_price": schema.Float64Attribute{
+			Description: "The monthly price of the nodes in this Node Pool in US dollars, " +
+				"taking region-specific pricing into account.",
+			Computed: true,
+			PlanModifiers: []planmodifier.Float64{
+				float64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"hourly_price": schema.Float64Attribute{
+			Description: "The hourly price of the nodes in this Node Pool in US dollars, " +
+				"taking region-specific pricing into account.",
+			Computed: true,
+			PlanModifiers: []planmodifier.Float64{
+				float64planmodifier.UseStateForUnknown(),
+			},
+		},
 	},
 	Blocks: map[string]schema.Block{
 		"autoscaler": schema.ListNestedBlock{
@@ -29,7 +29,10 @@ type NodeBalancerModel struct {
 	Updated            timetypes.RFC3339 `tfsdk:"updated"`
 	Transfer           types.List        `tfsdk:"transfer"`
 	Tags               types.Set         `tfsdk:"tags"`
+	TagsAll            types.Set         `tfsdk:"tags_all"`
 	Firewalls          types.List        `tfsdk:"firewalls"`
+	MonthlyPrice       types.Float64     `tfsdk:"monthly_price"`
+	HourlyPrice        types.Float64     `tfsdk:"hourly_price"`
 }
 
 type FirewallModel struct {
@@ -63,16 +66,23 @@ func (data *NodeBalancerModel) FlattenNodeBalancer(
 	ctx context.Context,
 	nodebalancer *linodego.NodeBalancer,
 	firewalls []linodego.Firewall,
+	providerTags helper.ProviderTags,
 	preserveKnown bool,
 ) diag.Diagnostics {
 	data.ID = helper.KeepOrUpdateString(data.ID, strconv.Itoa(nodebalancer.ID), preserveKnown)
 	data.Label = helper.KeepOrUpdateStringPointer(data.Label, nodebalancer.Label, preserveKnown)
 
-	tags, diags := types.SetValueFrom(ctx, types.StringType, helper.StringSliceToFramework(nodebalancer.Tags))
+	tagsSlice, tagsAll, diags := providerTags.FrameworkFlattenTags(ctx, nodebalancer.Tags, data.Tags)
+	if diags.HasError() {
+		return diags
+	}
+
+	tags, diags := types.SetValueFrom(ctx, types.StringType, helper.StringSliceToFramework(tagsSlice))
 	if diags.HasError() {
 		return diags
 	}
 	data.Tags = helper.KeepOrUpdateValue(data.Tags, tags, preserveKnown)
+	data.TagsAll = helper.KeepOrUpdateValue(data.TagsAll, tagsAll, preserveKnown)
 
 	data.Region = helper.KeepOrUpdateString(data.Region, nodebalancer.Region, preserveKnown)
 	data.ClientConnThrottle = helper.KeepOrUpdateInt64(
@@ -120,7 +130,10 @@ func (data *NodeBalancerModel) CopyFrom(other NodeBalancerModel, preserveKnown b
 	data.Updated = helper.KeepOrUpdateValue(data.Updated, other.Updated, preserveKnown)
 	data.Transfer = helper.KeepOrUpdateValue(data.Transfer, other.Transfer, preserveKnown)
 	data.Tags = helper.KeepOrUpdateValue(data.Tags, other.Tags, preserveKnown)
+	data.TagsAll = helper.KeepOrUpdateValue(data.TagsAll, other.TagsAll, preserveKnown)
 	data.Firewalls = helper.KeepOrUpdateValue(data.Firewalls, other.Firewalls, preserveKnown)
+	data.MonthlyPrice = helper.KeepOrUpdateValue(data.MonthlyPrice, other.MonthlyPrice, preserveKnown)
+	data.HourlyPrice = helper.KeepOrUpdateValue(data.HourlyPrice, other.HourlyPrice, preserveKnown)
 }
 
 func parseNBFirewalls(
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -26,7 +27,7 @@ func TestFlattenNodeBalancerPreserveKnown(t *testing.T) {
 		Label: types.StringValue("another" + label),
 	}
 
-	diags := nodeBalancerModel.FlattenNodeBalancer(context.Background(), nodeBalancer, nil, true)
+	diags := nodeBalancerModel.FlattenNodeBalancer(context.Background(), nodeBalancer, nil, helper.ProviderTags{}, true)
 
 	assert.False(t, diags.HasError(), "Errors should be returned due to custom context error")
 	assert.False(t, types.StringValue(label).Equal(nodeBalancerModel.Label))
@@ -66,7 +67,7 @@ func TestFlattenNodeBalancer(t *testing.T) {
 
 	nodeBalancerModel := &NodeBalancerModel{}
 
-	diags := nodeBalancerModel.FlattenNodeBalancer(context.Background(), nodeBalancer, nil, false)
+	diags := nodeBalancerModel.FlattenNodeBalancer(context.Background(), nodeBalancer, nil, helper.ProviderTags{}, false)
 
 	assert.False(t, diags.HasError())
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/linodego"
@@ -21,9 +22,10 @@ func NewResource() resource.Resource {
 	return &Resource{
 		BaseResource: helper.NewBaseResource(
 			helper.BaseResourceConfig{
-				Name:   "linode_nodebalancer",
-				IDType: types.StringType,
-				Schema: &frameworkResourceSchema,
+				Name:        "linode_nodebalancer",
+				IDType:      types.StringType,
+				Schema:      &frameworkResourceSchema,
+				ImportLabel: &importLabelConfig,
 			},
 		),
 	}
@@ -33,6 +35,23 @@ type Resource struct {
 	helper.BaseResource
 }
 
+var importLabelConfig = helper.ImportLabelConfig{
+	SupportsRegion: true,
+	Lookup: func(ctx context.Context, client *linodego.Client, filter string) ([]string, error) {
+		nbs, err := client.ListNodeBalancers(ctx, linodego.NewListOptions(0, filter))
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]string, 0, len(nbs))
+		for _, nb := range nbs {
+			result = append(result, strconv.Itoa(nb.ID))
+		}
+
+		return result, nil
+	},
+}
+
 func (r *Resource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -78,15 +97,18 @@ func (r *Resource) Create(
 		}
 	}
 
+	createOpts.Tags = r.Meta.Config.GetProviderTags(ctx).MergeTags(createOpts.Tags)
+
 	tflog.Debug(ctx, "client.CreateNodeBalancer(...)", map[string]any{
 		"options": createOpts,
 	})
 
 	nodebalancer, err := client.CreateNodeBalancer(ctx, createOpts)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helper.FrameworkAddAPIError(
+			ctx, &resp.Diagnostics, req.Plan,
 			"Error creating a Linode NodeBalancer",
-			err.Error(),
+			err,
 		)
 		return
 	}
@@ -99,7 +121,7 @@ func (r *Resource) Create(
 		)
 	}
 
-	resp.Diagnostics.Append(data.FlattenNodeBalancer(ctx, nodebalancer, firewalls, true)...)
+	resp.Diagnostics.Append(data.FlattenNodeBalancer(ctx, nodebalancer, firewalls, r.Meta.Config.GetProviderTags(ctx), true)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -108,6 +130,8 @@ func (r *Resource) Create(
 	// TODO: Remove when Crossplane empty string ID issue is resolved
 	data.ID = types.StringValue(strconv.Itoa(nodebalancer.ID))
 
+	r.setPrice(ctx, &data, nodebalancer)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -164,11 +188,13 @@ func (r *Resource) Read(
 		)
 	}
 
-	resp.Diagnostics.Append(data.FlattenNodeBalancer(ctx, nodeBalancer, firewalls, false)...)
+	resp.Diagnostics.Append(data.FlattenNodeBalancer(ctx, nodeBalancer, firewalls, r.Meta.Config.GetProviderTags(ctx), false)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	r.setPrice(ctx, &data, nodeBalancer)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -198,7 +224,8 @@ func (r *Resource) Update(
 
 	isEqual := state.Label.Equal(plan.Label) &&
 		state.ClientConnThrottle.Equal(plan.ClientConnThrottle) &&
-		state.Tags.Equal(plan.Tags)
+		state.Tags.Equal(plan.Tags) &&
+		state.TagsAll.Equal(plan.TagsAll)
 
 	if !isEqual {
 		clientConnThrottle := helper.FrameworkSafeInt64ToInt(
@@ -212,20 +239,42 @@ func (r *Resource) Update(
 			Label:              plan.Label.ValueStringPointer(),
 			ClientConnThrottle: &clientConnThrottle,
 		}
-		resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &updateOpts.Tags, false)...)
+		var tags []string
+
+		resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 
+		providerTags := r.Meta.Config.GetProviderTags(ctx)
+
+		// Ignored tags must be preserved when updating the tags
+		var remoteTags []string
+		if providerTags.HasIgnoreTags() {
+			nodeBalancer, err := client.GetNodeBalancer(ctx, id)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					fmt.Sprintf("Failed to Get NodeBalancer %v", id),
+					err.Error(),
+				)
+				return
+			}
+			remoteTags = nodeBalancer.Tags
+		}
+
+		tags = providerTags.UpdateTags(tags, remoteTags)
+		updateOpts.Tags = &tags
+
 		tflog.Debug(ctx, "client.UpdateNodeBalancer(...)", map[string]any{
 			"options": updateOpts,
 		})
 
 		nodeBalancer, err := client.UpdateNodeBalancer(ctx, id, updateOpts)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			helper.FrameworkAddAPIError(
+				ctx, &resp.Diagnostics, req.Plan,
 				fmt.Sprintf("Failed to Update NodeBalancer %v", id),
-				err.Error(),
+				err,
 			)
 			return
 		}
@@ -240,7 +289,7 @@ func (r *Resource) Update(
 			)
 		}
 
-		resp.Diagnostics.Append(plan.FlattenNodeBalancer(ctx, nodeBalancer, firewalls, true)...)
+		resp.Diagnostics.Append(plan.FlattenNodeBalancer(ctx, nodeBalancer, firewalls, r.Meta.Config.GetProviderTags(ctx), true)...)
 	}
 
 	plan.CopyFrom(state, true)
@@ -296,6 +345,36 @@ func (r *Resource) Delete(
 	}
 }
 
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	helper.FrameworkModifyPlanTagsAll(ctx, r.Meta, req, resp)
+	helper.FrameworkModifyPlanPrice(ctx, req, resp, func() (*helper.Price, error) {
+		var region types.String
+
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("region"), &region)...)
+		if resp.Diagnostics.HasError() || r.Meta == nil || !helper.FrameworkValuesKnown(region) {
+			return nil, nil
+		}
+
+		price, err := helper.GetNodeBalancerPrice(ctx, r.Meta.Client, region.ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		return &price, nil
+	}, "region")
+}
+
+// setPrice sets the computed price attributes of the given NodeBalancer.
+func (r *Resource) setPrice(ctx context.Context, data *NodeBalancerModel, nodeBalancer *linodego.NodeBalancer) {
+	helper.FrameworkSetPrice(ctx, &data.MonthlyPrice, &data.HourlyPrice, func() (helper.Price, error) {
+		return helper.GetNodeBalancerPrice(ctx, r.Meta.Client, nodeBalancer.Region)
+	})
+}
+
 func (r *Resource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{
 		0: {
@@ -328,6 +407,7 @@ func upgradeNodebalancerResourceStateV0toV1(
 		Created:            timetypes.RFC3339{StringValue: nbDataV0.Created},
 		Updated:            timetypes.RFC3339{StringValue: nbDataV0.Updated},
 		Tags:               nbDataV0.Tags,
+		TagsAll:            nbDataV0.Tags,
 		Firewalls:          types.ListNull(firewallObjType),
 	}
 
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -117,6 +118,11 @@ var frameworkResourceSchema = schema.Schema{
 			},
 			Description: "An array of tags applied to this object. Tags are for organizational purposes only.",
 		},
+		"tags_all": schema.SetAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+			Description: "All tags applied to this object, including those inherited from the provider's default_tags.",
+		},
 		"transfer": schema.ListAttribute{
 			Description: "Information about the amount of transfer this NodeBalancer has had so far this month.",
 			Computed:    true,
@@ -133,6 +139,20 @@ var frameworkResourceSchema = schema.Schema{
 				listplanmodifier.UseStateForUnknown(),
 			},
 		},
+		"monthly_price": schema.Float64Attribute{
+			Description: "The monthly price of this NodeBalancer in US dollars, taking region-specific pricing into account.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Float64{
+				float64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"hourly_price": schema.Float64Attribute{
+			Description: "The hourly price of this NodeBalancer in US dollars, taking region-specific pricing into account.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Float64{
+				float64planmodifier.UseStateForUnknown(),
+			},
+		},
 	},
 }
 
@@ -89,7 +89,11 @@ func (r *Resource) Create(
 
 	config, err := client.CreateNodeBalancerConfig(ctx, nodeBalancerID, *createOpts)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to Create Node Balancer Config", err.Error())
+		helper.FrameworkAddAPIError(
+			ctx, &resp.Diagnostics, req.Plan,
+			"Failed to Create Node Balancer Config",
+			err,
+		)
 		return
 	}
 
@@ -198,9 +202,10 @@ func (r *Resource) Update(
 
 	config, err := client.UpdateNodeBalancerConfig(ctx, nodeBalancerID, id, *updateOpts)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helper.FrameworkAddAPIError(
+			ctx, &resp.Diagnostics, req.Plan,
 			fmt.Sprintf("Failed to Update the NodeBalancer Config %v", id),
-			err.Error(),
+			err,
 		)
 		return
 	}
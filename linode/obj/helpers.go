@@ -128,6 +128,11 @@ func GetObjKeys(
 		// If object keys don't exist in the resource configuration, firstly look for the keys from provider configuration
 		if providerKeys, ok := getObjKeysFromProvider(objKeys, config); ok {
 			objKeys = providerKeys
+		} else if config.ObjUseTempKeys && config.ReadOnly {
+			// Temporary keys can't be created without mutating the account
+			return objKeys, diag.Errorf(
+				"access_key and secret_key are required, temporary keys can't be created when the provider is read_only.",
+			), nil
 		} else if config.ObjUseTempKeys {
 			// Implicitly create temporary object storage keys
 			keys, diag := createTempKeys(ctx, client, bucket, regionOrCluster, permission)
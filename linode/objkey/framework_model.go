@@ -62,7 +62,7 @@ func (plan ResourceModel) GetCreateOptions(ctx context.Context) (opts linodego.O
 		)
 
 		for i, v := range plan.BucketAccess {
-			accessSlice[i] = v.toLinodeObject()
+			accessSlice[i] = v.ToLinodeObject()
 		}
 
 		opts.BucketAccess = &accessSlice
@@ -183,7 +183,7 @@ func (b *BucketAccessModelEntry) FlattenBucketAccess(access *linodego.ObjectStor
 	b.Permissions = helper.KeepOrUpdateString(b.Permissions, access.Permissions, preserveKnown)
 }
 
-func (b *BucketAccessModelEntry) toLinodeObject() linodego.ObjectStorageKeyBucketAccess {
+func (b *BucketAccessModelEntry) ToLinodeObject() linodego.ObjectStorageKeyBucketAccess {
 	var result linodego.ObjectStorageKeyBucketAccess
 
 	result.BucketName = b.BucketName.ValueString()
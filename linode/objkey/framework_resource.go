@@ -55,9 +55,10 @@ func (r *Resource) Create(
 	})
 	key, err := client.CreateObjectStorageKey(ctx, createOpts)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helper.FrameworkAddAPIError(
+			ctx, &resp.Diagnostics, req.Plan,
 			"Failed to create Object Storage Key",
-			err.Error(),
+			err,
 		)
 		return
 	}
@@ -164,9 +165,11 @@ func (r *Resource) Update(
 		})
 		key, err := r.Meta.Client.UpdateObjectStorageKey(ctx, id, updateOpts)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			helper.FrameworkAddAPIError(
+				ctx, &resp.Diagnostics, req.Plan,
 				fmt.Sprintf("Failed to update Object Storage Key (%d)", id),
-				err.Error())
+				err,
+			)
 			return
 		}
 
@@ -0,0 +1,115 @@
+package objtempkey
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+// privateKeyID is the private data key the ID of the temporary key is stored under.
+const privateKeyID = "key_id"
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &EphemeralResource{
+		BaseEphemeralResource: helper.NewBaseEphemeralResource(
+			helper.BaseEphemeralResourceConfig{
+				Name:   "linode_object_storage_temp_key",
+				Schema: &frameworkEphemeralResourceSchema,
+			},
+		),
+	}
+}
+
+type EphemeralResource struct {
+	helper.BaseEphemeralResource
+}
+
+func (r *EphemeralResource) Open(
+	ctx context.Context,
+	req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse,
+) {
+	tflog.Debug(ctx, "Open "+r.Config.Name)
+
+	var data EphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createOpts := data.GetCreateOptions(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "client.CreateObjectStorageKey(...)", map[string]any{
+		"options": createOpts,
+	})
+
+	key, err := r.Meta.Client.CreateObjectStorageKey(ctx, createOpts)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Create Temporary Object Storage Key", err.Error())
+		return
+	}
+
+	// Terraform does not call Close after a failed Open,
+	// so the key is revoked here if anything else fails.
+	defer func() {
+		if resp.Diagnostics.HasError() {
+			r.revokeKey(ctx, key.ID, &resp.Diagnostics)
+		}
+	}()
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyID, []byte(strconv.Itoa(key.ID)))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.FlattenObjectStorageKey(ctx, key, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *EphemeralResource) Close(
+	ctx context.Context,
+	req ephemeral.CloseRequest,
+	resp *ephemeral.CloseResponse,
+) {
+	tflog.Debug(ctx, "Close "+r.Config.Name)
+
+	rawID, diags := req.Private.GetKey(ctx, privateKeyID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || rawID == nil {
+		return
+	}
+
+	id, err := strconv.Atoi(string(rawID))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Parse Temporary Object Storage Key ID", err.Error())
+		return
+	}
+
+	r.revokeKey(ctx, id, &resp.Diagnostics)
+}
+
+func (r *EphemeralResource) revokeKey(ctx context.Context, id int, diags *diag.Diagnostics) {
+	tflog.Debug(ctx, "client.DeleteObjectStorageKey(...)", map[string]any{
+		"key_id": id,
+	})
+
+	if err := r.Meta.Client.DeleteObjectStorageKey(ctx, id); err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed to Revoke Temporary Object Storage Key %d", id),
+			err.Error(),
+		)
+	}
+}
@@ -0,0 +1,89 @@
+package objtempkey
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var frameworkEphemeralResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"label": schema.StringAttribute{
+			Description: "The label given to the temporary key. Defaults to a generated label.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"regions": schema.SetAttribute{
+			Description: "A set of regions where the key will grant access to create buckets.",
+			Optional:    true,
+			Computed:    true,
+			ElementType: types.StringType,
+			Validators:  []validator.Set{setvalidator.SizeAtLeast(1)},
+		},
+		"id": schema.Int64Attribute{
+			Description: "The unique ID of the temporary key.",
+			Computed:    true,
+		},
+		"access_key": schema.StringAttribute{
+			Description: "The temporary key's access key.",
+			Computed:    true,
+		},
+		"secret_key": schema.StringAttribute{
+			Description: "The temporary key's secret key.",
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"limited": schema.BoolAttribute{
+			Description: "Whether or not the temporary key is a limited access key.",
+			Computed:    true,
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"bucket_access": schema.SetNestedBlock{
+			Description: "A list of permissions to grant the temporary key.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"bucket_name": schema.StringAttribute{
+						Description: "The unique label of the bucket to which the key will grant limited access.",
+						Required:    true,
+					},
+					"cluster": schema.StringAttribute{
+						Description: "The Object Storage cluster where the bucket resides. " +
+							"Deprecated in favor of `region`",
+						Optional: true,
+						Computed: true,
+						DeprecationMessage: "The `cluster` attribute in a `bucket_access` block has " +
+							"been deprecated in favor of `region` attribute. A cluster value can be " +
+							"converted to a region value by removing -x at the end, for example, a " +
+							"cluster value `us-mia-1` can be converted to region value `us-mia`",
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("region"),
+							),
+						},
+					},
+					"region": schema.StringAttribute{
+						Description: "The region where the bucket resides.",
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("cluster"),
+							),
+						},
+					},
+					"permissions": schema.StringAttribute{
+						Description: "The temporary key's permissions for the selected bucket.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("read_only", "read_write"),
+						},
+					},
+				},
+			},
+		},
+	},
+}
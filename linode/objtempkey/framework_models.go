@@ -0,0 +1,78 @@
+package objtempkey
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/objkey"
+)
+
+// EphemeralResourceModel describes the Terraform ephemeral resource data model
+// to match the ephemeral resource schema.
+type EphemeralResourceModel struct {
+	Label     types.String `tfsdk:"label"`
+	Regions   types.Set    `tfsdk:"regions"`
+	ID        types.Int64  `tfsdk:"id"`
+	AccessKey types.String `tfsdk:"access_key"`
+	SecretKey types.String `tfsdk:"secret_key"`
+	Limited   types.Bool   `tfsdk:"limited"`
+
+	BucketAccess []objkey.BucketAccessModelEntry `tfsdk:"bucket_access"`
+}
+
+func (data *EphemeralResourceModel) GetCreateOptions(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+) (opts linodego.ObjectStorageKeyCreateOptions) {
+	opts.Label = data.Label.ValueString()
+	if opts.Label == "" {
+		opts.Label = fmt.Sprintf("temp_%v", time.Now().Unix())
+	}
+
+	if data.BucketAccess != nil {
+		accessSlice := make([]linodego.ObjectStorageKeyBucketAccess, len(data.BucketAccess))
+
+		for i, v := range data.BucketAccess {
+			accessSlice[i] = v.ToLinodeObject()
+		}
+
+		opts.BucketAccess = &accessSlice
+	}
+
+	if !data.Regions.IsNull() && !data.Regions.IsUnknown() {
+		diags.Append(data.Regions.ElementsAs(ctx, &opts.Regions, false)...)
+	}
+
+	return
+}
+
+func (data *EphemeralResourceModel) FlattenObjectStorageKey(
+	ctx context.Context,
+	key *linodego.ObjectStorageKey,
+	diags *diag.Diagnostics,
+) {
+	data.ID = types.Int64Value(int64(key.ID))
+	data.Label = types.StringValue(key.Label)
+	data.AccessKey = types.StringValue(key.AccessKey)
+	data.SecretKey = types.StringValue(key.SecretKey)
+	data.Limited = types.BoolValue(key.Limited)
+
+	regionIDs := make([]string, len(key.Regions))
+	for i, r := range key.Regions {
+		regionIDs[i] = r.ID
+	}
+
+	regions, newDiags := types.SetValueFrom(ctx, types.StringType, regionIDs)
+	diags.Append(newDiags...)
+	if diags.HasError() {
+		return
+	}
+
+	data.Regions = regions
+
+	data.BucketAccess = objkey.FlattenBucketAccessEntries(key.BucketAccess, nil, false)
+}
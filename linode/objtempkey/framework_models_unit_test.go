@@ -0,0 +1,89 @@
+//go:build unit
+
+package objtempkey
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/objkey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCreateOptions(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	data := EphemeralResourceModel{
+		Regions: types.SetNull(types.StringType),
+		BucketAccess: []objkey.BucketAccessModelEntry{
+			{
+				BucketName:  types.StringValue("my-bucket"),
+				Cluster:     types.StringNull(),
+				Region:      types.StringValue("us-mia"),
+				Permissions: types.StringValue("read_only"),
+			},
+		},
+	}
+
+	opts := data.GetCreateOptions(ctx, &diags)
+	require.False(t, diags.HasError())
+
+	assert.True(t, strings.HasPrefix(opts.Label, "temp_"))
+	assert.Nil(t, opts.Regions)
+	require.NotNil(t, opts.BucketAccess)
+	assert.Equal(t, "my-bucket", (*opts.BucketAccess)[0].BucketName)
+	assert.Equal(t, "us-mia", (*opts.BucketAccess)[0].Region)
+	assert.Equal(t, "read_only", (*opts.BucketAccess)[0].Permissions)
+
+	data.Label = types.StringValue("my-key")
+	data.Regions, _ = types.SetValueFrom(ctx, types.StringType, []string{"us-east"})
+
+	opts = data.GetCreateOptions(ctx, &diags)
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, "my-key", opts.Label)
+	assert.Equal(t, []string{"us-east"}, opts.Regions)
+}
+
+func TestFlattenObjectStorageKey(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	key := linodego.ObjectStorageKey{
+		ID:        123,
+		Label:     "temp_1234",
+		AccessKey: "ACCESSKEY",
+		SecretKey: "SECRETKEY",
+		Limited:   true,
+		BucketAccess: &[]linodego.ObjectStorageKeyBucketAccess{
+			{
+				BucketName:  "my-bucket",
+				Region:      "us-mia",
+				Permissions: "read_write",
+			},
+		},
+		Regions: []linodego.ObjectStorageKeyRegion{
+			{ID: "us-mia"},
+		},
+	}
+
+	var data EphemeralResourceModel
+	data.FlattenObjectStorageKey(ctx, &key, &diags)
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, int64(123), data.ID.ValueInt64())
+	assert.Equal(t, "temp_1234", data.Label.ValueString())
+	assert.Equal(t, "ACCESSKEY", data.AccessKey.ValueString())
+	assert.Equal(t, "SECRETKEY", data.SecretKey.ValueString())
+	assert.True(t, data.Limited.ValueBool())
+	assert.Len(t, data.Regions.Elements(), 1)
+	require.Len(t, data.BucketAccess, 1)
+	assert.Equal(t, "my-bucket", data.BucketAccess[0].BucketName.ValueString())
+	assert.Equal(t, "read_write", data.BucketAccess[0].Permissions.ValueString())
+}
@@ -0,0 +1,64 @@
+package parsekubeconfig
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+func NewFunction() function.Function {
+	return &Function{}
+}
+
+type Function struct{}
+
+func (f *Function) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "parse_kubeconfig"
+}
+
+func (f *Function) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a kubeconfig into its connection details.",
+		Description: "Parses a base64-encoded or raw YAML kubeconfig, such as the kubeconfig " +
+			"attribute of a linode_lke_cluster, and returns the connection details of its current context.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "kubeconfig",
+				Description: "The base64-encoded or raw YAML kubeconfig to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: kubeconfigAttributeTypes,
+		},
+	}
+}
+
+func (f *Function) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var input string
+
+	resp.Error = req.Arguments.Get(ctx, &input)
+	if resp.Error != nil {
+		return
+	}
+
+	var result KubeconfigModel
+
+	if err := result.ParseKubeconfig(input); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Failed to parse kubeconfig: "+err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, &result)
+}
@@ -0,0 +1,184 @@
+package parsekubeconfig
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// KubeconfigModel describes the object returned by the function.
+type KubeconfigModel struct {
+	Host                 types.String `tfsdk:"host"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	Token                types.String `tfsdk:"token"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+	Context              types.String `tfsdk:"context"`
+}
+
+var kubeconfigAttributeTypes = map[string]attr.Type{
+	"host":                   types.StringType,
+	"cluster_ca_certificate": types.StringType,
+	"token":                  types.StringType,
+	"client_certificate":     types.StringType,
+	"client_key":             types.StringType,
+	"context":                types.StringType,
+}
+
+type kubeconfig struct {
+	CurrentContext string `yaml:"current-context"`
+
+	Clusters []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			Token                 string `yaml:"token"`
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKeyData         string `yaml:"client-key-data"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+}
+
+// decodeKubeconfig returns the raw YAML of the given kubeconfig,
+// which may either be base64-encoded or raw YAML.
+func decodeKubeconfig(input string) []byte {
+	input = strings.TrimSpace(input)
+
+	if decoded, err := base64.StdEncoding.DecodeString(input); err == nil {
+		return decoded
+	}
+
+	return []byte(input)
+}
+
+// ParseKubeconfig parses the given base64-encoded or raw kubeconfig
+// and returns the connection details of its current context.
+func (data *KubeconfigModel) ParseKubeconfig(input string) error {
+	if strings.TrimSpace(input) == "" {
+		return fmt.Errorf("kubeconfig must not be empty")
+	}
+
+	var config kubeconfig
+	if err := yaml.Unmarshal(decodeKubeconfig(input), &config); err != nil {
+		return fmt.Errorf("failed to parse kubeconfig YAML: %w", err)
+	}
+
+	if len(config.Contexts) == 0 {
+		return fmt.Errorf("kubeconfig does not contain any contexts")
+	}
+
+	// Fall back to the first context if no current context is set
+	contextIndex := 0
+	if config.CurrentContext != "" {
+		contextIndex = -1
+
+		for i, c := range config.Contexts {
+			if c.Name == config.CurrentContext {
+				contextIndex = i
+				break
+			}
+		}
+
+		if contextIndex < 0 {
+			return fmt.Errorf("current context %q not found in kubeconfig", config.CurrentContext)
+		}
+	}
+
+	context := config.Contexts[contextIndex]
+	data.Context = types.StringValue(context.Name)
+
+	clusterFound := false
+
+	for _, c := range config.Clusters {
+		if c.Name != context.Context.Cluster {
+			continue
+		}
+
+		caCertificate, err := decodeData(c.Cluster.CertificateAuthorityData)
+		if err != nil {
+			return fmt.Errorf("failed to decode certificate-authority-data of cluster %q: %w", c.Name, err)
+		}
+
+		data.Host = types.StringValue(c.Cluster.Server)
+		data.ClusterCACertificate = caCertificate
+		clusterFound = true
+
+		break
+	}
+
+	if !clusterFound {
+		return fmt.Errorf("cluster %q of context %q not found in kubeconfig", context.Context.Cluster, context.Name)
+	}
+
+	userFound := false
+
+	for _, u := range config.Users {
+		if u.Name != context.Context.User {
+			continue
+		}
+
+		clientCertificate, err := decodeData(u.User.ClientCertificateData)
+		if err != nil {
+			return fmt.Errorf("failed to decode client-certificate-data of user %q: %w", u.Name, err)
+		}
+
+		clientKey, err := decodeData(u.User.ClientKeyData)
+		if err != nil {
+			return fmt.Errorf("failed to decode client-key-data of user %q: %w", u.Name, err)
+		}
+
+		data.Token = optionalString(u.User.Token)
+		data.ClientCertificate = clientCertificate
+		data.ClientKey = clientKey
+		userFound = true
+
+		break
+	}
+
+	if !userFound {
+		return fmt.Errorf("user %q of context %q not found in kubeconfig", context.Context.User, context.Name)
+	}
+
+	return nil
+}
+
+// decodeData decodes a base64-encoded *-data field of a kubeconfig.
+func decodeData(value string) (types.String, error) {
+	if value == "" {
+		return types.StringNull(), nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	return types.StringValue(string(decoded)), nil
+}
+
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
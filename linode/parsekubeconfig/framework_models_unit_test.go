@@ -0,0 +1,73 @@
+//go:build unit
+
+package parsekubeconfig
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testKubeconfig = `
+apiVersion: v1
+kind: Config
+current-context: lke12345-ctx
+clusters:
+- name: lke12345
+  cluster:
+    server: https://12345.us-east-1.linodelke.net:443
+    certificate-authority-data: ` + base64.StdEncoding.EncodeToString([]byte("ca-certificate")) + `
+users:
+- name: lke12345-admin
+  user:
+    token: secret-token
+    client-certificate-data: ` + base64.StdEncoding.EncodeToString([]byte("client-certificate")) + `
+    client-key-data: ` + base64.StdEncoding.EncodeToString([]byte("client-key")) + `
+contexts:
+- name: other-ctx
+  context:
+    cluster: other
+    user: other
+- name: lke12345-ctx
+  context:
+    cluster: lke12345
+    user: lke12345-admin
+`
+
+func TestParseKubeconfig(t *testing.T) {
+	for name, input := range map[string]string{
+		"raw":    testKubeconfig,
+		"base64": base64.StdEncoding.EncodeToString([]byte(testKubeconfig)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			var data KubeconfigModel
+
+			require.NoError(t, data.ParseKubeconfig(input))
+
+			assert.Equal(t, "https://12345.us-east-1.linodelke.net:443", data.Host.ValueString())
+			assert.Equal(t, "ca-certificate", data.ClusterCACertificate.ValueString())
+			assert.Equal(t, "secret-token", data.Token.ValueString())
+			assert.Equal(t, "client-certificate", data.ClientCertificate.ValueString())
+			assert.Equal(t, "client-key", data.ClientKey.ValueString())
+			assert.Equal(t, "lke12345-ctx", data.Context.ValueString())
+		})
+	}
+}
+
+func TestParseKubeconfig_malformed(t *testing.T) {
+	for name, input := range map[string]string{
+		"empty":           "",
+		"invalid YAML":    "clusters: [",
+		"no contexts":     "clusters: []",
+		"missing context": "current-context: foo\ncontexts:\n- name: bar",
+		"missing cluster": "contexts:\n- name: foo\n  context:\n    cluster: foo",
+	} {
+		t.Run(name, func(t *testing.T) {
+			var data KubeconfigModel
+
+			assert.Error(t, data.ParseKubeconfig(input))
+		})
+	}
+}
@@ -29,6 +29,14 @@ type Resource struct {
 	helper.BaseResource
 }
 
+func (r *Resource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	helper.FrameworkModifyPlanRegionCapabilities(
+		ctx, r.Meta, req, resp, linodego.CapabilityPlacementGroup,
+	)
+}
+
 func (r *Resource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -56,9 +64,10 @@ func (r *Resource) Create(
 	})
 	pg, err := client.CreatePlacementGroup(ctx, createOpts)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helper.FrameworkAddAPIError(
+			ctx, &resp.Diagnostics, req.Plan,
 			"Failed to create Placement Group.",
-			err.Error(),
+			err,
 		)
 		return
 	}
@@ -168,9 +177,10 @@ func (r *Resource) Update(
 
 		pg, err := client.UpdatePlacementGroup(ctx, id, updateOpts)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			helper.FrameworkAddAPIError(
+				ctx, &resp.Diagnostics, req.Plan,
 				fmt.Sprintf("Failed to update Placement Group (%d).", id),
-				err.Error(),
+				err,
 			)
 			return
 		}
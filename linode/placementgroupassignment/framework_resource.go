@@ -59,9 +59,10 @@ func (r *Resource) Create(
 	})
 	pg, err := client.AssignPlacementGroupLinodes(ctx, pgID, assignOpts)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helper.FrameworkAddAPIError(
+			ctx, &resp.Diagnostics, req.Plan,
 			"Error assigning Linode to Placement Group",
-			err.Error(),
+			err,
 		)
 		return
 	}
@@ -35,6 +35,20 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: "The token that allows you access to your Linode account",
 			},
+			"token_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The path to a file containing the token that allows you access to your Linode account.",
+				ConflictsWith: []string{"token_command"},
+			},
+			"token_command": {
+				Type:          schema.TypeList,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Optional:      true,
+				MinItems:      1,
+				Description:   "A command and its arguments to run to get the token that allows you access to your Linode account.",
+				ConflictsWith: []string{"token_file"},
+			},
 			"config_path": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -61,6 +75,11 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: "The version of Linode API.",
 			},
+			"child_account_euuid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The EUUID of a child account to manage resources in using a proxy token.",
+			},
 
 			"skip_instance_ready_poll": {
 				Type:        schema.TypeBool,
@@ -111,6 +130,69 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: "The rate in milliseconds to poll for an LKE node to be ready.",
 			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The maximum number of concurrent requests to the Linode API.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Description:  "The maximum number of requests per second to the Linode API.",
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"default_tags": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Tags to apply to all taggable resources managed by this provider.",
+			},
+			"ignore_tags": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Tag prefixes to ignore on all taggable resources managed by this provider.",
+			},
+			"retry_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "An additional rule retrying failed requests to the Linode API.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status_codes": {
+							Type:        schema.TypeSet,
+							Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(100, 599)},
+							Required:    true,
+							MinItems:    1,
+							Description: "The HTTP status codes of the responses to retry.",
+						},
+						"path_regex": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "A regular expression matching the paths of the requests to retry.",
+							ValidateFunc: validation.StringIsValidRegExp,
+						},
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The maximum number of attempts of a matching request. (default `5`)",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"audit_log_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path of a file to append a JSON line to for each API request mutating the account.",
+			},
+			"read_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "If true, the provider refuses to send any API request other than GET requests, " +
+					"guaranteeing the account is not modified.",
+			},
 			"obj_access_key": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -176,6 +258,14 @@ func handleDefault(config *helper.Config, d *schema.ResourceData) diag.Diagnosti
 		config.AccessToken = os.Getenv("LINODE_TOKEN")
 	}
 
+	if v, ok := d.GetOk("token_file"); ok {
+		config.TokenFile = v.(string)
+	}
+
+	if v, ok := d.GetOk("token_command"); ok {
+		config.TokenCommand = helper.ExpandStringList(v.([]any))
+	}
+
 	if v, ok := d.GetOk("api_version"); ok {
 		config.APIVersion = v.(string)
 	} else {
@@ -213,6 +303,12 @@ func handleDefault(config *helper.Config, d *schema.ResourceData) diag.Diagnosti
 		config.UAPrefix = os.Getenv("LINODE_UA_PREFIX")
 	}
 
+	if v, ok := d.GetOk("child_account_euuid"); ok {
+		config.ChildAccountEUUID = v.(string)
+	} else {
+		config.ChildAccountEUUID = os.Getenv("LINODE_CHILD_ACCOUNT_EUUID")
+	}
+
 	if v, ok := d.GetOk("event_poll_ms"); ok {
 		config.EventPollMilliseconds = v.(int)
 	} else {
@@ -263,6 +359,17 @@ func providerConfigure(
 		MinRetryDelayMilliseconds: d.Get("min_retry_delay_ms").(int),
 		MaxRetryDelayMilliseconds: d.Get("max_retry_delay_ms").(int),
 
+		MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+		RequestsPerSecond:     d.Get("requests_per_second").(float64),
+
+		RetryRules: expandRetryRules(d.Get("retry_rule").([]any)),
+
+		AuditLogPath: d.Get("audit_log_path").(string),
+		ReadOnly:     d.Get("read_only").(bool),
+
+		DefaultTags: helper.ExpandStringSet(d.Get("default_tags").(*schema.Set)),
+		IgnoreTags:  helper.ExpandStringSet(d.Get("ignore_tags").(*schema.Set)),
+
 		ObjUseTempKeys:       d.Get("obj_use_temp_keys").(bool),
 		ObjBucketForceDelete: d.Get("obj_bucket_force_delete").(bool),
 	}
@@ -279,8 +386,31 @@ func providerConfigure(
 	if _, err := client.ListTypes(ctx, linodego.NewListOptions(100, "")); err != nil {
 		return nil, diag.Errorf("Error connecting to the Linode API: %s", err)
 	}
-	return &helper.ProviderMeta{
+	meta := &helper.ProviderMeta{
 		Client: *client,
 		Config: config,
-	}, nil
+	}
+	meta.EventWatcher = helper.NewEventWatcher(&meta.Client)
+	meta.LinodeMutex = helper.LinodeMutex()
+
+	return meta, nil
+}
+
+func expandRetryRules(rules []any) []helper.RetryRule {
+	result := make([]helper.RetryRule, 0, len(rules))
+
+	for _, rule := range rules {
+		ruleMap, ok := rule.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		result = append(result, helper.RetryRule{
+			StatusCodes: helper.ExpandIntSet(ruleMap["status_codes"].(*schema.Set)),
+			PathRegex:   ruleMap["path_regex"].(string),
+			MaxAttempts: ruleMap["max_attempts"].(int),
+		})
+	}
+
+	return result
 }
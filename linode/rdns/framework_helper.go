@@ -51,7 +51,7 @@ func updateIPAddressWithRetries(ctx context.Context, client *linodego.Client, ad
 			if err != nil {
 				if lerr, ok := err.(*linodego.Error); ok && lerr.Code != 400 &&
 					!strings.Contains(lerr.Error(), "unable to perform a lookup") {
-					return nil, fmt.Errorf("failed to update ip address: %s", err)
+					return nil, fmt.Errorf("failed to update ip address: %w", err)
 				}
 
 				tflog.Debug(ctx, "IP is not yet ready for assignment")
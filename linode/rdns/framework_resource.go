@@ -98,9 +98,10 @@ func (r *Resource) Create(
 		plan.WaitForAvailable.ValueBool(),
 	)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helper.FrameworkAddAPIError(
+			ctx, &resp.Diagnostics, req.Plan,
 			"Failed to create Linode RDNS",
-			err.Error(),
+			err,
 		)
 		return
 	}
@@ -200,9 +201,10 @@ func (r *Resource) Update(
 			plan.WaitForAvailable.ValueBool(),
 		)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			helper.FrameworkAddAPIError(
+				ctx, &resp.Diagnostics, req.Plan,
 				"Failed to update the Linode RDNS",
-				err.Error(),
+				err,
 			)
 			return
 		}
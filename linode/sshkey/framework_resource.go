@@ -55,9 +55,10 @@ func (r *Resource) Create(
 
 	key, err := client.CreateSSHKey(ctx, createOpts)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helper.FrameworkAddAPIError(
+			ctx, &resp.Diagnostics, req.Plan,
 			"Failed to create SSH Key",
-			err.Error(),
+			err,
 		)
 		return
 	}
@@ -159,9 +160,11 @@ func (r *Resource) Update(
 			updateOpts,
 		)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			helper.FrameworkAddAPIError(
+				ctx, &resp.Diagnostics, req.Plan,
 				fmt.Sprintf("Failed to update SSH Key (%d)", id),
-				err.Error())
+				err,
+			)
 			return
 		}
 		plan.FlattenSSHKey(key, true)
@@ -115,9 +115,10 @@ func (r *Resource) Create(
 
 	stackscript, err := client.CreateStackscript(ctx, createOpts)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helper.FrameworkAddAPIError(
+			ctx, &resp.Diagnostics, req.Plan,
 			"StackScript creation error",
-			err.Error(),
+			err,
 		)
 		return
 	}
@@ -227,7 +228,7 @@ func (r *Resource) Update(
 
 	// Apply the change if necessary
 	if !isUnchanged {
-		r.updateStackScript(ctx, resp, &plan, stackScriptID)
+		r.updateStackScript(ctx, req, resp, &plan, stackScriptID)
 	}
 
 	plan.CopyFrom(state, true)
@@ -278,6 +279,7 @@ func (r *Resource) Delete(
 
 func (r *Resource) updateStackScript(
 	ctx context.Context,
+	req resource.UpdateRequest,
 	resp *resource.UpdateResponse,
 	plan *StackScriptModel,
 	stackScriptID int,
@@ -304,9 +306,10 @@ func (r *Resource) updateStackScript(
 
 	stackscript, err := client.UpdateStackscript(ctx, stackScriptID, updateOpts)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helper.FrameworkAddAPIError(
+			ctx, &resp.Diagnostics, req.Plan,
 			fmt.Sprintf("Failed to update the StackScript with id %v", stackScriptID),
-			err.Error(),
+			err,
 		)
 		return
 	}
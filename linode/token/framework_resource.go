@@ -66,10 +66,7 @@ func (r *Resource) Create(
 	})
 	token, err := client.CreateToken(ctx, createOpts)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Token creation error",
-			err.Error(),
-		)
+		helper.FrameworkAddAPIError(ctx, &resp.Diagnostics, req.Plan, "Token creation error", err)
 		return
 	}
 
@@ -178,9 +175,10 @@ func (r *Resource) Update(
 		})
 		_, err = client.UpdateToken(ctx, token.ID, updateOpts)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			helper.FrameworkAddAPIError(
+				ctx, &resp.Diagnostics, req.Plan,
 				fmt.Sprintf("Failed to update the token with id %v", tokenID),
-				err.Error(),
+				err,
 			)
 			return
 		}
@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/linodego"
@@ -74,11 +73,19 @@ type VolumeResourceModel struct {
 	LinodeID       types.Int64    `tfsdk:"linode_id"`
 	FilesystemPath types.String   `tfsdk:"filesystem_path"`
 	Tags           types.Set      `tfsdk:"tags"`
+	TagsAll        types.Set      `tfsdk:"tags_all"`
 	Status         types.String   `tfsdk:"status"`
+	MonthlyPrice   types.Float64  `tfsdk:"monthly_price"`
+	HourlyPrice    types.Float64  `tfsdk:"hourly_price"`
 	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
-func (data *VolumeResourceModel) FlattenVolume(volume *linodego.Volume, preserveKnown bool) diag.Diagnostics {
+func (data *VolumeResourceModel) FlattenVolume(
+	ctx context.Context,
+	volume *linodego.Volume,
+	providerTags helper.ProviderTags,
+	preserveKnown bool,
+) diag.Diagnostics {
 	var diags diag.Diagnostics
 	if volume == nil {
 		diags.AddError(
@@ -100,18 +107,20 @@ func (data *VolumeResourceModel) FlattenVolume(volume *linodego.Volume, preserve
 
 	data.FilesystemPath = helper.KeepOrUpdateString(data.FilesystemPath, volume.FilesystemPath, preserveKnown)
 
-	tagValues := make([]attr.Value, len(volume.Tags))
-	for i, tag := range volume.Tags {
-		tagValues[i] = types.StringValue(tag)
+	tags, tagsAll, d := providerTags.FrameworkFlattenTags(ctx, volume.Tags, data.Tags)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
 	}
 
-	tagsSetValue, diags := types.SetValue(types.StringType, tagValues)
-	diags.Append(diags...)
+	tagsSetValue, d := types.SetValueFrom(ctx, types.StringType, tags)
+	diags.Append(d...)
 	if diags.HasError() {
 		return diags
 	}
 
 	data.Tags = helper.KeepOrUpdateValue(data.Tags, tagsSetValue, preserveKnown)
+	data.TagsAll = helper.KeepOrUpdateValue(data.TagsAll, tagsAll, preserveKnown)
 
 	data.Status = helper.KeepOrUpdateString(data.Status, string(volume.Status), preserveKnown)
 
@@ -127,6 +136,9 @@ func (data *VolumeResourceModel) CopyFrom(other VolumeResourceModel, preserveKno
 	data.LinodeID = helper.KeepOrUpdateValue(data.LinodeID, other.LinodeID, preserveKnown)
 	data.FilesystemPath = helper.KeepOrUpdateValue(data.FilesystemPath, other.FilesystemPath, preserveKnown)
 	data.Tags = helper.KeepOrUpdateValue(data.Tags, other.Tags, preserveKnown)
+	data.TagsAll = helper.KeepOrUpdateValue(data.TagsAll, other.TagsAll, preserveKnown)
 	data.Status = helper.KeepOrUpdateValue(data.Status, other.Status, preserveKnown)
+	data.MonthlyPrice = helper.KeepOrUpdateValue(data.MonthlyPrice, other.MonthlyPrice, preserveKnown)
+	data.HourlyPrice = helper.KeepOrUpdateValue(data.HourlyPrice, other.HourlyPrice, preserveKnown)
 	data.Timeouts = helper.KeepOrUpdateValue(data.Timeouts, other.Timeouts, preserveKnown)
 }
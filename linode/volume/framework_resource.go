@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/linode/linodego"
@@ -25,9 +26,10 @@ func NewResource() resource.Resource {
 	return &Resource{
 		BaseResource: helper.NewBaseResource(
 			helper.BaseResourceConfig{
-				Name:   "linode_volume",
-				IDType: types.StringType,
-				Schema: &frameworkResourceSchema,
+				Name:        "linode_volume",
+				IDType:      types.StringType,
+				Schema:      &frameworkResourceSchema,
+				ImportLabel: &importLabelConfig,
 				TimeoutOpts: &timeouts.Opts{
 					Update: true,
 					Create: true,
@@ -42,6 +44,23 @@ type Resource struct {
 	helper.BaseResource
 }
 
+var importLabelConfig = helper.ImportLabelConfig{
+	SupportsRegion: true,
+	Lookup: func(ctx context.Context, client *linodego.Client, filter string) ([]string, error) {
+		vs, err := client.ListVolumes(ctx, linodego.NewListOptions(0, filter))
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]string, 0, len(vs))
+		for _, v := range vs {
+			result = append(result, strconv.Itoa(v.ID))
+		}
+
+		return result, nil
+	},
+}
+
 func cloneCheck(data *VolumeResourceModel, sourceVolume *linodego.Volume, diags *diag.Diagnostics) {
 	if sourceVolume == nil {
 		diags.AddError(
@@ -79,7 +98,11 @@ func cloneCheck(data *VolumeResourceModel, sourceVolume *linodego.Volume, diags
 }
 
 func (r *Resource) CreateVolumeFromSource(
-	ctx context.Context, data *VolumeResourceModel, diags *diag.Diagnostics, timeoutSeconds int,
+	ctx context.Context,
+	tfPlan tfsdk.Plan,
+	data *VolumeResourceModel,
+	diags *diag.Diagnostics,
+	timeoutSeconds int,
 ) *linodego.Volume {
 	tflog.Debug(ctx, "Create volume from source")
 
@@ -106,9 +129,10 @@ func (r *Resource) CreateVolumeFromSource(
 
 	clonedVolume, err := client.CloneVolume(ctx, sourceVolumeID, data.Label.ValueString())
 	if err != nil {
-		diags.AddError(
+		helper.FrameworkAddAPIError(
+			ctx, diags, tfPlan,
 			fmt.Sprintf("Failed to Clone Volume %d", sourceVolumeID),
-			err.Error(),
+			err,
 		)
 		return clonedVolume
 	}
@@ -131,11 +155,16 @@ func (r *Resource) CreateVolumeFromSource(
 	var updateOpts linodego.VolumeUpdateOptions
 
 	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		diags.Append(data.Tags.ElementsAs(ctx, &updateOpts.Tags, false)...)
+		var tags []string
+
+		diags.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
 		if diags.HasError() {
 			return clonedVolume
 		}
 
+		tags = r.Meta.Config.GetProviderTags(ctx).UpdateTags(tags, clonedVolume.Tags)
+		updateOpts.Tags = &tags
+
 		tflog.Debug(ctx, "client.UpdateVolume(...)", map[string]any{
 			"options": updateOpts,
 		})
@@ -147,9 +176,10 @@ func (r *Resource) CreateVolumeFromSource(
 		}
 
 		if err != nil {
-			diags.AddError(
+			helper.FrameworkAddAPIError(
+				ctx, diags, tfPlan,
 				fmt.Sprintf("Failed to Update Cloned Volume %d", clonedVolume.ID),
-				err.Error(),
+				err,
 			)
 			return clonedVolume
 		}
@@ -161,7 +191,7 @@ func (r *Resource) CreateVolumeFromSource(
 			return clonedVolume
 		}
 		if newSize != clonedVolume.Size {
-			resizedVolume := HandleResize(ctx, client, clonedVolume.ID, newSize, timeoutSeconds, diags)
+			resizedVolume := HandleResize(ctx, client, tfPlan, clonedVolume.ID, newSize, timeoutSeconds, diags)
 			if resizedVolume != nil {
 				clonedVolume = resizedVolume
 			}
@@ -177,6 +207,13 @@ func (r *Resource) CreateVolumeFromSource(
 			return clonedVolume
 		}
 
+		unlock, err := r.Meta.LockLinode(ctx, linodeID)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Failed to Lock Linode %d", linodeID), err.Error())
+			return clonedVolume
+		}
+		defer unlock()
+
 		attachOptions := &linodego.VolumeAttachOptions{LinodeID: linodeID}
 
 		tflog.Debug(ctx, "client.AttachVolume(...)", map[string]any{
@@ -207,7 +244,11 @@ func (r *Resource) CreateVolumeFromSource(
 }
 
 func (r *Resource) CreateVolume(
-	ctx context.Context, data *VolumeResourceModel, diags *diag.Diagnostics, timeoutSeconds int,
+	ctx context.Context,
+	tfPlan tfsdk.Plan,
+	data *VolumeResourceModel,
+	diags *diag.Diagnostics,
+	timeoutSeconds int,
 ) *linodego.Volume {
 	tflog.Debug(ctx, "Create new volume")
 
@@ -221,17 +262,28 @@ func (r *Resource) CreateVolume(
 		Size:   size,
 	}
 
-	diags.Append(data.Tags.ElementsAs(ctx, &createOpts.Tags, false)...)
+	var tags []string
+
+	diags.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
 	if diags.HasError() {
 		return nil
 	}
 
+	createOpts.Tags = r.Meta.Config.GetProviderTags(ctx).MergeTags(tags)
+
 	if !data.LinodeID.IsNull() && !data.LinodeID.IsUnknown() {
 		linodeID := helper.FrameworkSafeInt64ToInt(data.LinodeID.ValueInt64(), diags)
 		if diags.HasError() {
 			return nil
 		}
 		createOpts.LinodeID = linodeID
+
+		unlock, err := r.Meta.LockLinode(ctx, linodeID)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Failed to Lock Linode %d", linodeID), err.Error())
+			return nil
+		}
+		defer unlock()
 	}
 
 	tflog.Debug(ctx, "client.CreateVolume(...)", map[string]interface{}{
@@ -240,7 +292,7 @@ func (r *Resource) CreateVolume(
 
 	volume, err := client.CreateVolume(ctx, createOpts)
 	if err != nil {
-		diags.AddError("Failed to Create a Volume", err.Error())
+		helper.FrameworkAddAPIError(ctx, diags, tfPlan, "Failed to Create a Volume", err)
 		return volume
 	}
 
@@ -288,20 +340,22 @@ func (r *Resource) Create(
 	var volume *linodego.Volume
 
 	if !plan.SourceVolumeID.IsNull() {
-		volume = r.CreateVolumeFromSource(ctx, &plan, &resp.Diagnostics, timeoutSeconds)
+		volume = r.CreateVolumeFromSource(ctx, req.Plan, &plan, &resp.Diagnostics, timeoutSeconds)
 	} else {
-		volume = r.CreateVolume(ctx, &plan, &resp.Diagnostics, timeoutSeconds)
+		volume = r.CreateVolume(ctx, req.Plan, &plan, &resp.Diagnostics, timeoutSeconds)
 	}
 
 	if volume != nil {
 		// We should always set the created resource into state even if there is an error
 		// to prevent untracked resources created on the cloud
-		plan.FlattenVolume(volume, true)
+		plan.FlattenVolume(ctx, volume, r.Meta.Config.GetProviderTags(ctx), true)
 
 		// IDs should always be overridden during creation (see #1085)
 		// TODO: Remove when Crossplane empty string ID issue is resolved
 		plan.ID = types.StringValue(strconv.Itoa(volume.ID))
 
+		r.setPrice(ctx, &plan, volume)
+
 		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	}
 }
@@ -348,16 +402,54 @@ func (r *Resource) Read(
 		return
 	}
 
-	resp.Diagnostics.Append(state.FlattenVolume(volume, false)...)
+	resp.Diagnostics.Append(state.FlattenVolume(ctx, volume, r.Meta.Config.GetProviderTags(ctx), false)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	r.setPrice(ctx, &state, volume)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (r *Resource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	helper.FrameworkModifyPlanTagsAll(ctx, r.Meta, req, resp)
+	helper.FrameworkModifyPlanRegionCapabilities(
+		ctx, r.Meta, req, resp, linodego.CapabilityBlockStorage,
+	)
+	helper.FrameworkModifyPlanPrice(ctx, req, resp, func() (*helper.Price, error) {
+		var plan VolumeResourceModel
+
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		if resp.Diagnostics.HasError() || r.Meta == nil ||
+			!helper.FrameworkValuesKnown(plan.Region, plan.Size) {
+			return nil, nil
+		}
+
+		price, err := helper.GetVolumePrice(
+			ctx, r.Meta.Client, plan.Region.ValueString(), int(plan.Size.ValueInt64()),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return &price, nil
+	}, "region", "size")
+}
+
+// setPrice sets the computed price attributes of the given volume.
+func (r *Resource) setPrice(ctx context.Context, data *VolumeResourceModel, volume *linodego.Volume) {
+	helper.FrameworkSetPrice(ctx, &data.MonthlyPrice, &data.HourlyPrice, func() (helper.Price, error) {
+		return helper.GetVolumePrice(ctx, r.Meta.Client, volume.Region, volume.Size)
+	})
+}
+
 func HandleResize(
 	ctx context.Context,
 	client *linodego.Client,
+	tfPlan tfsdk.Plan,
 	volumeID, newSize, timeoutSeconds int,
 	diags *diag.Diagnostics,
 ) *linodego.Volume {
@@ -368,9 +460,10 @@ func HandleResize(
 
 	tflog.Trace(ctx, "client.ResizeVolume(...)")
 	if err := client.ResizeVolume(ctx, volumeID, newSize); err != nil {
-		diags.AddError(
+		helper.FrameworkAddAPIError(
+			ctx, diags, tfPlan,
 			fmt.Sprintf("Failed to Resize Volume %d", volumeID),
-			err.Error(),
+			err,
 		)
 		return nil
 	}
@@ -429,16 +522,18 @@ func (r *Resource) Update(
 	}
 
 	if !state.Size.Equal(plan.Size) {
-		volume := HandleResize(ctx, client, id, size, timeoutSeconds, &resp.Diagnostics)
+		volume := HandleResize(ctx, client, req.Plan, id, size, timeoutSeconds, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 
 		id = volume.ID
-		resp.Diagnostics.Append(plan.FlattenVolume(volume, true)...)
+		resp.Diagnostics.Append(plan.FlattenVolume(ctx, volume, r.Meta.Config.GetProviderTags(ctx), true)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+
+		r.setPrice(ctx, &plan, volume)
 	}
 
 	updateOpts := linodego.VolumeUpdateOptions{
@@ -446,12 +541,34 @@ func (r *Resource) Update(
 	}
 	doUpdate := false
 
-	if !state.Tags.Equal(plan.Tags) {
+	if !state.Tags.Equal(plan.Tags) || !state.TagsAll.Equal(plan.TagsAll) {
 		doUpdate = true
-		resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &updateOpts.Tags, false)...)
+
+		var tags []string
+
+		resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+
+		providerTags := r.Meta.Config.GetProviderTags(ctx)
+
+		// Ignored tags must be preserved when updating the tags
+		var remoteTags []string
+		if providerTags.HasIgnoreTags() {
+			volume, err := client.GetVolume(ctx, id)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					fmt.Sprintf("Failed to Get Volume %d", id),
+					err.Error(),
+				)
+				return
+			}
+			remoteTags = volume.Tags
+		}
+
+		tags = providerTags.UpdateTags(tags, remoteTags)
+		updateOpts.Tags = &tags
 	}
 
 	if !state.Label.Equal(plan.Label) {
@@ -466,14 +583,15 @@ func (r *Resource) Update(
 
 		volume, err := client.UpdateVolume(ctx, id, updateOpts)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			helper.FrameworkAddAPIError(
+				ctx, &resp.Diagnostics, req.Plan,
 				fmt.Sprintf("Failed to Update Volume %d", id),
-				err.Error(),
+				err,
 			)
 			return
 		}
 
-		resp.Diagnostics.Append(plan.FlattenVolume(volume, true)...)
+		resp.Diagnostics.Append(plan.FlattenVolume(ctx, volume, r.Meta.Config.GetProviderTags(ctx), true)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -484,12 +602,24 @@ func (r *Resource) Update(
 		if !state.LinodeID.IsNull() {
 			// we need to detach if this volume attached to any linode
 
+			stateLinodeID := helper.FrameworkSafeInt64ToInt(state.LinodeID.ValueInt64(), &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			unlock, err := r.Meta.LockLinode(ctx, stateLinodeID)
+			if err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("Failed to Lock Linode %d", stateLinodeID), err.Error())
+				return
+			}
+
 			volume := DetachVolumeAndWait(ctx, client, id, timeoutSeconds, &resp.Diagnostics)
+			unlock()
 			if resp.Diagnostics.HasError() {
 				return
 			}
 
-			resp.Diagnostics.Append(plan.FlattenVolume(volume, true)...)
+			resp.Diagnostics.Append(plan.FlattenVolume(ctx, volume, r.Meta.Config.GetProviderTags(ctx), true)...)
 			if resp.Diagnostics.HasError() {
 				return
 			}
@@ -503,6 +633,13 @@ func (r *Resource) Update(
 				return
 			}
 
+			unlock, err := r.Meta.LockLinode(ctx, linodeID)
+			if err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("Failed to Lock Linode %d", linodeID), err.Error())
+				return
+			}
+			defer unlock()
+
 			attachOptions := linodego.VolumeAttachOptions{
 				LinodeID: linodeID,
 				ConfigID: 0,
@@ -512,11 +649,12 @@ func (r *Resource) Update(
 				"options": attachOptions,
 			})
 
-			_, err := client.AttachVolume(ctx, id, &attachOptions)
+			_, err = client.AttachVolume(ctx, id, &attachOptions)
 			if err != nil {
-				resp.Diagnostics.AddError(
+				helper.FrameworkAddAPIError(
+					ctx, &resp.Diagnostics, req.Plan,
 					fmt.Sprintf("Failed to Attach Volume %d to Linode %d", id, linodeID),
-					err.Error(),
+					err,
 				)
 				return
 			}
@@ -532,7 +670,7 @@ func (r *Resource) Update(
 				return
 			}
 
-			resp.Diagnostics.Append(plan.FlattenVolume(volume, true)...)
+			resp.Diagnostics.Append(plan.FlattenVolume(ctx, volume, r.Meta.Config.GetProviderTags(ctx), true)...)
 			if resp.Diagnostics.HasError() {
 				return
 			}
@@ -622,7 +760,19 @@ func (r *Resource) Delete(
 	}
 
 	if !state.LinodeID.IsNull() {
+		linodeID := helper.FrameworkSafeInt64ToInt(state.LinodeID.ValueInt64(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		unlock, err := r.Meta.LockLinode(ctx, linodeID)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to Lock Linode %d", linodeID), err.Error())
+			return
+		}
+
 		DetachVolumeAndWait(ctx, client, id, timeoutSeconds, &resp.Diagnostics)
+		unlock()
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -111,5 +112,24 @@ var frameworkResourceSchema = schema.Schema{
 			},
 			Default: helper.EmptySetDefault(types.StringType),
 		},
+		"tags_all": schema.SetAttribute{
+			Description: "All tags applied to this object, including those inherited from the provider's default_tags.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"monthly_price": schema.Float64Attribute{
+			Description: "The monthly price of this volume in US dollars, taking region-specific pricing into account.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Float64{
+				float64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"hourly_price": schema.Float64Attribute{
+			Description: "The hourly price of this volume in US dollars, taking region-specific pricing into account.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Float64{
+				float64planmodifier.UseStateForUnknown(),
+			},
+		},
 	},
 }
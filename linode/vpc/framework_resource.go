@@ -17,9 +17,10 @@ func NewResource() resource.Resource {
 	return &Resource{
 		BaseResource: helper.NewBaseResource(
 			helper.BaseResourceConfig{
-				Name:   "linode_vpc",
-				IDType: types.StringType,
-				Schema: &frameworkResourceSchema,
+				Name:        "linode_vpc",
+				IDType:      types.StringType,
+				Schema:      &frameworkResourceSchema,
+				ImportLabel: &importLabelConfig,
 			},
 		),
 	}
@@ -29,6 +30,23 @@ type Resource struct {
 	helper.BaseResource
 }
 
+var importLabelConfig = helper.ImportLabelConfig{
+	SupportsRegion: true,
+	Lookup: func(ctx context.Context, client *linodego.Client, filter string) ([]string, error) {
+		vpcs, err := client.ListVPCs(ctx, linodego.NewListOptions(0, filter))
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]string, 0, len(vpcs))
+		for _, vpc := range vpcs {
+			result = append(result, strconv.Itoa(vpc.ID))
+		}
+
+		return result, nil
+	},
+}
+
 func (r *Resource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -56,10 +74,7 @@ func (r *Resource) Create(
 	})
 	vpc, err := client.CreateVPC(ctx, vpcCreateOpts)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to create VPC.",
-			err.Error(),
-		)
+		helper.FrameworkAddAPIError(ctx, &resp.Diagnostics, req.Plan, "Failed to create VPC.", err)
 		return
 	}
 
@@ -165,9 +180,10 @@ func (r *Resource) Update(
 		})
 		vpc, err := client.UpdateVPC(ctx, id, updateOpts)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			helper.FrameworkAddAPIError(
+				ctx, &resp.Diagnostics, req.Plan,
 				fmt.Sprintf("Failed to update VPC (%d).", id),
-				err.Error(),
+				err,
 			)
 			return
 		}
@@ -84,9 +84,10 @@ func (r *Resource) Create(
 	})
 	subnet, err := client.CreateVPCSubnet(ctx, createOpts, vpcId)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helper.FrameworkAddAPIError(
+			ctx, &resp.Diagnostics, req.Plan,
 			"Failed to create VPC subnet.",
-			err.Error(),
+			err,
 		)
 		return
 	}
@@ -201,9 +202,10 @@ func (r *Resource) Update(
 		})
 		subnet, err := client.UpdateVPCSubnet(ctx, vpcId, id, updateOpts)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			helper.FrameworkAddAPIError(
+				ctx, &resp.Diagnostics, req.Plan,
 				fmt.Sprintf("Failed to update VPC subnet (%d).", id),
-				err.Error(),
+				err,
 			)
 			return
 		}
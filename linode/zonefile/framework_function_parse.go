@@ -0,0 +1,63 @@
+package zonefile
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+func NewParseFunction() function.Function {
+	return &ParseFunction{}
+}
+
+type ParseFunction struct{}
+
+func (f *ParseFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "parse_zonefile"
+}
+
+func (f *ParseFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a BIND zone file into a list of DNS records.",
+		Description: "Parses a BIND zone file into a list of objects matching the arguments " +
+			"of the linode_domain_record resource. SOA records and NS records for the zone apex are skipped.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "zonefile",
+				Description: "The contents of the BIND zone file to parse.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: recordObjectType,
+		},
+	}
+}
+
+func (f *ParseFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var zoneFile string
+
+	resp.Error = req.Arguments.Get(ctx, &zoneFile)
+	if resp.Error != nil {
+		return
+	}
+
+	records, err := ParseZoneFile(zoneFile)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Failed to parse zone file: "+err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, records)
+}
@@ -0,0 +1,67 @@
+package zonefile
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+func NewRenderFunction() function.Function {
+	return &RenderFunction{}
+}
+
+type RenderFunction struct{}
+
+func (f *RenderFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "render_zonefile"
+}
+
+func (f *RenderFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Render a list of DNS records as a BIND zone file.",
+		Description: "Renders a list of objects matching the arguments of the linode_domain_record " +
+			"resource, such as the result of parse_zonefile, as a BIND zone file for the given domain.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "domain",
+				Description: "The domain the records belong to.",
+			},
+			function.ListParameter{
+				Name:        "records",
+				Description: "The records to render.",
+				ElementType: recordObjectType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *RenderFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var domain string
+	var records []RecordModel
+
+	resp.Error = req.Arguments.Get(ctx, &domain, &records)
+	if resp.Error != nil {
+		return
+	}
+
+	zoneFile, err := RenderZoneFile(domain, records)
+	if err != nil {
+		resp.Error = function.NewFuncError("Failed to render zone file: " + err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, zoneFile)
+}
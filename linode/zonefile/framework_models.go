@@ -0,0 +1,281 @@
+package zonefile
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/miekg/dns"
+)
+
+// placeholderOrigin is used to resolve relative names in zone files
+// without an $ORIGIN directive, e.g. zone files returned by the
+// linode_domain_zonefile data source.
+const placeholderOrigin = "origin.zonefile.invalid."
+
+// txtChunkSize is the maximum length of a single TXT record string.
+const txtChunkSize = 255
+
+var originRegex = regexp.MustCompile(`(?m)^\$ORIGIN\s+(\S+)`)
+
+// RecordModel describes a DNS record, matching the
+// arguments of the linode_domain_record resource.
+type RecordModel struct {
+	Name       types.String `tfsdk:"name"`
+	RecordType types.String `tfsdk:"record_type"`
+	TTLSec     types.Int64  `tfsdk:"ttl_sec"`
+	Target     types.String `tfsdk:"target"`
+	Priority   types.Int64  `tfsdk:"priority"`
+	Protocol   types.String `tfsdk:"protocol"`
+	Service    types.String `tfsdk:"service"`
+	Tag        types.String `tfsdk:"tag"`
+	Port       types.Int64  `tfsdk:"port"`
+	Weight     types.Int64  `tfsdk:"weight"`
+}
+
+var recordObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":        types.StringType,
+		"record_type": types.StringType,
+		"ttl_sec":     types.Int64Type,
+		"target":      types.StringType,
+		"priority":    types.Int64Type,
+		"protocol":    types.StringType,
+		"service":     types.StringType,
+		"tag":         types.StringType,
+		"port":        types.Int64Type,
+		"weight":      types.Int64Type,
+	},
+}
+
+// ParseZoneFile parses the records of the given BIND zone file.
+// SOA records and NS records for the zone apex are skipped because
+// they are managed by Linode.
+func ParseZoneFile(zoneFile string) ([]RecordModel, error) {
+	origin := placeholderOrigin
+	if match := originRegex.FindStringSubmatch(zoneFile); match != nil {
+		origin = dns.Fqdn(match[1])
+	}
+
+	parser := dns.NewZoneParser(strings.NewReader(zoneFile), placeholderOrigin, "")
+
+	result := make([]RecordModel, 0)
+
+	for rr, ok := parser.Next(); ok; rr, ok = parser.Next() {
+		header := rr.Header()
+		name := relativeName(header.Name, origin)
+
+		record := RecordModel{
+			Name:       types.StringValue(name),
+			RecordType: types.StringValue(dns.TypeToString[header.Rrtype]),
+			TTLSec:     types.Int64Value(int64(header.Ttl)),
+			Priority:   types.Int64Null(),
+			Protocol:   types.StringNull(),
+			Service:    types.StringNull(),
+			Tag:        types.StringNull(),
+			Port:       types.Int64Null(),
+			Weight:     types.Int64Null(),
+		}
+
+		switch r := rr.(type) {
+		case *dns.SOA:
+			continue
+		case *dns.A:
+			record.Target = types.StringValue(r.A.String())
+		case *dns.AAAA:
+			record.Target = types.StringValue(r.AAAA.String())
+		case *dns.NS:
+			if name == "" {
+				continue
+			}
+			record.Target = types.StringValue(targetName(r.Ns))
+		case *dns.MX:
+			record.Target = types.StringValue(targetName(r.Mx))
+			record.Priority = types.Int64Value(int64(r.Preference))
+		case *dns.CNAME:
+			record.Target = types.StringValue(targetName(r.Target))
+		case *dns.TXT:
+			record.Target = types.StringValue(strings.Join(r.Txt, ""))
+		case *dns.PTR:
+			record.Target = types.StringValue(targetName(r.Ptr))
+		case *dns.CAA:
+			record.Target = types.StringValue(r.Value)
+			record.Tag = types.StringValue(r.Tag)
+		case *dns.SRV:
+			labels := dns.SplitDomainName(name)
+			if len(labels) < 2 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+				return nil, fmt.Errorf(
+					"SRV record %s must be named _service._protocol", strings.TrimSuffix(header.Name, "."),
+				)
+			}
+
+			// The names of SRV records are generated by Linode
+			record.Name = types.StringNull()
+			record.Service = types.StringValue(strings.TrimPrefix(labels[0], "_"))
+			record.Protocol = types.StringValue(strings.TrimPrefix(labels[1], "_"))
+			record.Target = types.StringValue(targetName(r.Target))
+			record.Priority = types.Int64Value(int64(r.Priority))
+			record.Weight = types.Int64Value(int64(r.Weight))
+			record.Port = types.Int64Value(int64(r.Port))
+		default:
+			return nil, fmt.Errorf(
+				"unsupported record type %s for %s", dns.TypeToString[header.Rrtype], strings.TrimSuffix(header.Name, "."),
+			)
+		}
+
+		result = append(result, record)
+	}
+
+	if err := parser.Err(); err != nil {
+		return nil, fmt.Errorf("failed to parse zone file: %w", err)
+	}
+
+	return result, nil
+}
+
+// RenderZoneFile renders the given records of the given domain as a BIND zone file.
+func RenderZoneFile(domain string, records []RecordModel) (string, error) {
+	origin := dns.Fqdn(domain)
+	if _, ok := dns.IsDomainName(origin); !ok || domain == "" {
+		return "", fmt.Errorf("invalid domain %q", domain)
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("$ORIGIN %s\n", origin))
+
+	for i, record := range records {
+		rr, err := record.toRR(origin)
+		if err != nil {
+			return "", fmt.Errorf("invalid record %d: %w", i, err)
+		}
+
+		sb.WriteString(rr.String())
+		sb.WriteString("\n")
+	}
+
+	return sb.String(), nil
+}
+
+func (data RecordModel) toRR(origin string) (dns.RR, error) {
+	recordType := strings.ToUpper(data.RecordType.ValueString())
+
+	rrType, ok := dns.StringToType[recordType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported record type %q", data.RecordType.ValueString())
+	}
+
+	name := absoluteName(data.Name.ValueString(), origin)
+	if rrType == dns.TypeSRV {
+		if data.Service.ValueString() == "" || data.Protocol.ValueString() == "" {
+			return nil, fmt.Errorf("SRV records require a service and protocol")
+		}
+
+		name = fmt.Sprintf("_%s._%s.%s", data.Service.ValueString(), data.Protocol.ValueString(), origin)
+	}
+
+	header := dns.RR_Header{
+		Name:   name,
+		Rrtype: rrType,
+		Class:  dns.ClassINET,
+		Ttl:    uint32(data.TTLSec.ValueInt64()),
+	}
+
+	target := data.Target.ValueString()
+
+	switch rrType {
+	case dns.TypeA, dns.TypeAAAA:
+		ip := net.ParseIP(target)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid IP address %q", target)
+		}
+
+		if rrType == dns.TypeA {
+			return &dns.A{Hdr: header, A: ip}, nil
+		}
+
+		return &dns.AAAA{Hdr: header, AAAA: ip}, nil
+	case dns.TypeNS:
+		return &dns.NS{Hdr: header, Ns: absoluteName(target, origin)}, nil
+	case dns.TypeMX:
+		return &dns.MX{
+			Hdr:        header,
+			Preference: uint16(data.Priority.ValueInt64()),
+			Mx:         absoluteName(target, origin),
+		}, nil
+	case dns.TypeCNAME:
+		return &dns.CNAME{Hdr: header, Target: absoluteName(target, origin)}, nil
+	case dns.TypeTXT:
+		return &dns.TXT{Hdr: header, Txt: splitTXT(target)}, nil
+	case dns.TypePTR:
+		return &dns.PTR{Hdr: header, Ptr: absoluteName(target, origin)}, nil
+	case dns.TypeCAA:
+		return &dns.CAA{Hdr: header, Tag: data.Tag.ValueString(), Value: target}, nil
+	case dns.TypeSRV:
+		return &dns.SRV{
+			Hdr:      header,
+			Priority: uint16(data.Priority.ValueInt64()),
+			Weight:   uint16(data.Weight.ValueInt64()),
+			Port:     uint16(data.Port.ValueInt64()),
+			Target:   absoluteName(target, origin),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported record type %q", data.RecordType.ValueString())
+}
+
+// relativeName returns the given record name relative to the zone origin,
+// returning an empty string for the zone apex.
+func relativeName(name, origin string) string {
+	for _, o := range []string{placeholderOrigin, origin} {
+		if strings.EqualFold(name, o) {
+			return ""
+		}
+
+		if dns.IsSubDomain(o, name) {
+			return strings.TrimSuffix(name[:len(name)-len(o)], ".")
+		}
+	}
+
+	return strings.TrimSuffix(name, ".")
+}
+
+// targetName returns the given record target as a fully qualified name
+// without a trailing dot, or relative to the zone origin if the zone
+// file does not specify its origin.
+func targetName(name string) string {
+	if dns.IsSubDomain(placeholderOrigin, name) {
+		return relativeName(name, placeholderOrigin)
+	}
+
+	return strings.TrimSuffix(name, ".")
+}
+
+// absoluteName returns the fully qualified form of the given name.
+// Names without dots are considered relative to the zone origin.
+func absoluteName(name, origin string) string {
+	switch {
+	case name == "" || name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return name
+	case !strings.Contains(name, "."):
+		return name + "." + origin
+	}
+
+	return dns.Fqdn(name)
+}
+
+func splitTXT(value string) []string {
+	result := make([]string, 0, len(value)/txtChunkSize+1)
+
+	for len(value) > txtChunkSize {
+		result = append(result, value[:txtChunkSize])
+		value = value[txtChunkSize:]
+	}
+
+	return append(result, value)
+}
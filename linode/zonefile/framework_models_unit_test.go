@@ -0,0 +1,105 @@
+//go:build unit
+
+package zonefile
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testZoneFile = `; example.com [123]
+$TTL 3600
+@  IN  SOA  ns1.linode.com. user.example.com. 2021000066 14400 14400 1209600 86400
+@    NS  ns1.linode.com.
+@    NS  ns2.linode.com.
+@       A       192.0.2.1
+www  300  A  192.0.2.2
+www     AAAA    2001:db8::1
+@       MX      10 mail
+blog    CNAME   www.example.net.
+@       TXT     "v=spf1 " "-all"
+@       CAA     0 issue "letsencrypt.org"
+_sip._tcp  SRV  10 20 5060 sip.example.net.
+sub     NS      ns1.example.net.
+`
+
+func TestParseZoneFile(t *testing.T) {
+	records, err := ParseZoneFile(testZoneFile)
+	require.NoError(t, err)
+	require.Len(t, records, 9)
+
+	assert.Equal(t, "", records[0].Name.ValueString())
+	assert.Equal(t, "A", records[0].RecordType.ValueString())
+	assert.Equal(t, "192.0.2.1", records[0].Target.ValueString())
+	assert.Equal(t, int64(3600), records[0].TTLSec.ValueInt64())
+	assert.True(t, records[0].Priority.IsNull())
+
+	assert.Equal(t, "www", records[1].Name.ValueString())
+	assert.Equal(t, int64(300), records[1].TTLSec.ValueInt64())
+
+	assert.Equal(t, "2001:db8::1", records[2].Target.ValueString())
+
+	assert.Equal(t, "MX", records[3].RecordType.ValueString())
+	assert.Equal(t, "mail", records[3].Target.ValueString())
+	assert.Equal(t, int64(10), records[3].Priority.ValueInt64())
+
+	assert.Equal(t, "www.example.net", records[4].Target.ValueString())
+
+	assert.Equal(t, "v=spf1 -all", records[5].Target.ValueString())
+
+	assert.Equal(t, "issue", records[6].Tag.ValueString())
+	assert.Equal(t, "letsencrypt.org", records[6].Target.ValueString())
+
+	assert.True(t, records[7].Name.IsNull())
+	assert.Equal(t, "sip", records[7].Service.ValueString())
+	assert.Equal(t, "tcp", records[7].Protocol.ValueString())
+	assert.Equal(t, int64(5060), records[7].Port.ValueInt64())
+	assert.Equal(t, int64(20), records[7].Weight.ValueInt64())
+
+	assert.Equal(t, "sub", records[8].Name.ValueString())
+	assert.Equal(t, "NS", records[8].RecordType.ValueString())
+}
+
+func TestParseZoneFile_origin(t *testing.T) {
+	records, err := ParseZoneFile("$ORIGIN example.com.\n$TTL 300\nwww A 192.0.2.1\n@ CNAME www\n")
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	assert.Equal(t, "www", records[0].Name.ValueString())
+	assert.Equal(t, "", records[1].Name.ValueString())
+	assert.Equal(t, "www.example.com", records[1].Target.ValueString())
+}
+
+func TestParseZoneFile_malformed(t *testing.T) {
+	_, err := ParseZoneFile("www IN A not-an-ip\n")
+	assert.Error(t, err)
+
+	_, err = ParseZoneFile("www IN HINFO \"cpu\" \"os\"\n")
+	assert.ErrorContains(t, err, "unsupported record type HINFO")
+}
+
+func TestRenderZoneFile(t *testing.T) {
+	records, err := ParseZoneFile(testZoneFile)
+	require.NoError(t, err)
+
+	zoneFile, err := RenderZoneFile("example.com", records)
+	require.NoError(t, err)
+
+	assert.Contains(t, zoneFile, "$ORIGIN example.com.\n")
+	assert.Contains(t, zoneFile, "www.example.com.\t300\tIN\tA\t192.0.2.2\n")
+	assert.Contains(t, zoneFile, "example.com.\t3600\tIN\tMX\t10 mail.example.com.\n")
+	assert.Contains(t, zoneFile, "_sip._tcp.example.com.\t3600\tIN\tSRV\t10 20 5060 sip.example.net.\n")
+
+	// Rendered zone files can be parsed again
+	reparsed, err := ParseZoneFile(zoneFile)
+	require.NoError(t, err)
+	assert.Len(t, reparsed, len(records))
+
+	_, err = RenderZoneFile("example.com", []RecordModel{
+		{RecordType: types.StringValue("A"), Target: types.StringValue("invalid")},
+	})
+	assert.Error(t, err)
+}
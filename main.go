@@ -4,12 +4,15 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/linode/terraform-provider-linode/v2/linode"
+	"github.com/linode/terraform-provider-linode/v2/linode/export"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 	"github.com/linode/terraform-provider-linode/v2/version"
 )
 
@@ -19,6 +22,13 @@ func main() {
 	// Disable the baked-in timestamp in favor of tflog
 	log.SetFlags(log.Flags() &^ (log.Ldate | log.Ltime))
 
+	if len(os.Args) > 1 && os.Args[1] == export.CommandName {
+		if err := export.Run(ctx, os.Args[2:], os.Stdout, os.Stderr); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -44,7 +54,9 @@ func main() {
 
 	err = tf5server.Serve(
 		"registry.terraform.io/linode/linode",
-		muxServer.ProviderServer,
+		func() tfprotov5.ProviderServer {
+			return helper.NewAPIRequestServer(muxServer.ProviderServer())
+		},
 		serveOpts...,
 	)
 	if err != nil {